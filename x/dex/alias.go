@@ -0,0 +1,67 @@
+package dex
+
+import (
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+const (
+	// ModuleName is the name of the dex module
+	ModuleName = types.ModuleName
+	// StoreKey is the string store representation
+	StoreKey = types.StoreKey
+	// RouterKey is the message route for the dex module
+	RouterKey = types.RouterKey
+	// QuerierRoute is the querier route for the dex module
+	QuerierRoute = types.QuerierRoute
+
+	// DefaultMaxPriceDigitSize is the default number of significant digits allowed in a price
+	DefaultMaxPriceDigitSize = types.DefaultMaxPriceDigitSize
+	// DefaultMaxQuantityDigitSize is the default number of significant digits allowed in a quantity
+	DefaultMaxQuantityDigitSize = types.DefaultMaxQuantityDigitSize
+)
+
+// DefaultTokenPairDeposit is the default deposit amount backing a freshly listed token pair
+var DefaultTokenPairDeposit = types.DefaultTokenPairDeposit
+
+type (
+	// TokenPair is an alias of types.TokenPair
+	TokenPair = types.TokenPair
+
+	// MsgList is an alias of types.MsgList
+	MsgList = types.MsgList
+	// MsgDeposit is an alias of types.MsgDeposit
+	MsgDeposit = types.MsgDeposit
+	// MsgWithdraw is an alias of types.MsgWithdraw
+	MsgWithdraw = types.MsgWithdraw
+	// MsgTransferOwnership is an alias of types.MsgTransferOwnership
+	MsgTransferOwnership = types.MsgTransferOwnership
+	// MsgConfirmOwnership is an alias of types.MsgConfirmOwnership
+	MsgConfirmOwnership = types.MsgConfirmOwnership
+	// MsgCreateOperator is an alias of types.MsgCreateOperator
+	MsgCreateOperator = types.MsgCreateOperator
+	// MsgUpdateOperator is an alias of types.MsgUpdateOperator
+	MsgUpdateOperator = types.MsgUpdateOperator
+	// MsgListMini is an alias of types.MsgListMini
+	MsgListMini = types.MsgListMini
+	// TokenPairKind is an alias of types.TokenPairKind
+	TokenPairKind = types.TokenPairKind
+	// MsgPauseOperator is an alias of types.MsgPauseOperator
+	MsgPauseOperator = types.MsgPauseOperator
+	// MsgBlockAddress is an alias of types.MsgBlockAddress
+	MsgBlockAddress = types.MsgBlockAddress
+	// MsgUnblockAddress is an alias of types.MsgUnblockAddress
+	MsgUnblockAddress = types.MsgUnblockAddress
+	// MsgClaimOperatorFees is an alias of types.MsgClaimOperatorFees
+	MsgClaimOperatorFees = types.MsgClaimOperatorFees
+	// MsgProposeTokenPairUpdate is an alias of types.MsgProposeTokenPairUpdate
+	MsgProposeTokenPairUpdate = types.MsgProposeTokenPairUpdate
+	// MsgConfirmTokenPairUpdate is an alias of types.MsgConfirmTokenPairUpdate
+	MsgConfirmTokenPairUpdate = types.MsgConfirmTokenPairUpdate
+)
+
+const (
+	// TokenPairKindStandard is an alias of types.TokenPairKindStandard
+	TokenPairKindStandard = types.TokenPairKindStandard
+	// TokenPairKindMini is an alias of types.TokenPairKindMini
+	TokenPairKindMini = types.TokenPairKindMini
+)
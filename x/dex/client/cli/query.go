@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+// GetQueryListCountCmd queries an operator's current listing rate-limit window
+func GetQueryListCountCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-count [operator-address]",
+		Short: "Query an operator's current token-pair listing rate-limit window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			operator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryOperatorListCountParams(operator)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryListCount), bz)
+			if err != nil {
+				return err
+			}
+
+			var window types.ListCountWindow
+			cdc.MustUnmarshalJSON(res, &window)
+			return cliCtx.PrintOutput(window)
+		},
+	}
+}
+
+// GetQueryPendingDelistingsCmd queries every delisting that has passed governance
+// but has not yet reached its effective height
+func GetQueryPendingDelistingsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-delistings",
+		Short: "Query token pair delistings awaiting their effective height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPendingDelistings), nil)
+			if err != nil {
+				return err
+			}
+
+			var pending []types.PendingDelisting
+			cdc.MustUnmarshalJSON(res, &pending)
+			return cliCtx.PrintOutput(pending)
+		},
+	}
+}
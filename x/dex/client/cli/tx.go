@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"bufio"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+// GetCmdSubmitDelistProposal implements the command to submit a delist-token-pair gov proposal
+func GetCmdSubmitDelistProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delist-token-pair [product] [reason] [effective-height]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to delist a token pair",
+		Long:  "Submit a proposal, together with an initial deposit, to mark a listed token pair as delisting at a given block height.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := utils.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			title := cmd.Flag("title").Value.String()
+			description := cmd.Flag("description").Value.String()
+			depositStr := cmd.Flag("deposit").Value.String()
+
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			effectiveHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewDelistProposal(title, description, args[0], args[1], effectiveHeight)
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String("title", "", "title of the delist proposal")
+	cmd.Flags().String("description", "", "description of the delist proposal")
+	cmd.Flags().String("deposit", "", "deposit of the delist proposal")
+	return cmd
+}
+
+// GetCmdSubmitRelistProposal implements the command to submit a relist-token-pair gov proposal
+func GetCmdSubmitRelistProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relist-token-pair [product] [reason] [effective-height]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to relist a previously delisted token pair",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := utils.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			title := cmd.Flag("title").Value.String()
+			description := cmd.Flag("description").Value.String()
+			depositStr := cmd.Flag("deposit").Value.String()
+
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			effectiveHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRelistProposal(title, description, args[0], args[1], effectiveHeight)
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String("title", "", "title of the relist proposal")
+	cmd.Flags().String("description", "", "description of the relist proposal")
+	cmd.Flags().String("deposit", "", "deposit of the relist proposal")
+	return cmd
+}
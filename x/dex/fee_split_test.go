@@ -0,0 +1,128 @@
+package dex
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+func TestCollectFeeWithOperatorSplit_SplitsBetweenCollectorAndOperator(t *testing.T) {
+	ctx := newTestContext()
+	keeper := newMockKeeper()
+	keeper.params.OperatorFeeShare = sdk.NewDecWithPrec(2, 1) // 20%
+
+	payer := testAddr(1)
+	operator := types.DEXOperator{Address: testAddr(2), HandlingFeeAddress: testAddr(3)}
+	feeCoins := sdk.NewCoins(sdk.NewCoin("okt", sdk.NewInt(100)))
+
+	err := collectFeeWithOperatorSplit(ctx, keeper, payer, operator, feeCoins, "btc_okt")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantCollector := sdk.NewCoins(sdk.NewCoin("okt", sdk.NewInt(80)))
+	wantOperator := sdk.NewCoins(sdk.NewCoin("okt", sdk.NewInt(20)))
+
+	if len(keeper.supplyKeeper.sentToModule) != 2 {
+		t.Fatalf("expected 2 transfers (collector + operator), got %d", len(keeper.supplyKeeper.sentToModule))
+	}
+	if !keeper.supplyKeeper.sentToModule[0].IsEqual(wantCollector) {
+		t.Errorf("fee collector got %s, want %s", keeper.supplyKeeper.sentToModule[0], wantCollector)
+	}
+	if !keeper.supplyKeeper.sentToModule[1].IsEqual(wantOperator) {
+		t.Errorf("dex module account got %s, want %s", keeper.supplyKeeper.sentToModule[1], wantOperator)
+	}
+	if accrued := keeper.GetAccruedOperatorFees(ctx, operator.Address); !accrued.IsEqual(wantOperator) {
+		t.Errorf("accrued operator fees = %s, want %s", accrued, wantOperator)
+	}
+}
+
+func TestCollectFeeWithOperatorSplit_ZeroShareSendsEverythingToCollector(t *testing.T) {
+	ctx := newTestContext()
+	keeper := newMockKeeper()
+	keeper.params.OperatorFeeShare = sdk.ZeroDec()
+
+	payer := testAddr(1)
+	operator := types.DEXOperator{Address: testAddr(2), HandlingFeeAddress: testAddr(3)}
+	feeCoins := sdk.NewCoins(sdk.NewCoin("okt", sdk.NewInt(100)))
+
+	if err := collectFeeWithOperatorSplit(ctx, keeper, payer, operator, feeCoins, "btc_okt"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(keeper.supplyKeeper.sentToModule) != 1 {
+		t.Fatalf("expected a single transfer to the fee collector, got %d", len(keeper.supplyKeeper.sentToModule))
+	}
+	if !keeper.supplyKeeper.sentToModule[0].IsEqual(feeCoins) {
+		t.Errorf("fee collector got %s, want %s", keeper.supplyKeeper.sentToModule[0], feeCoins)
+	}
+	if accrued := keeper.GetAccruedOperatorFees(ctx, operator.Address); !accrued.Empty() {
+		t.Errorf("operator should not have accrued any fees, got %s", accrued)
+	}
+}
+
+func TestCollectFeeWithOperatorSplit_PropagatesPayerTransferError(t *testing.T) {
+	ctx := newTestContext()
+	keeper := newMockKeeper()
+	keeper.params.OperatorFeeShare = sdk.NewDecWithPrec(2, 1)
+	keeper.supplyKeeper.failNext = true
+
+	operator := types.DEXOperator{Address: testAddr(2), HandlingFeeAddress: testAddr(3)}
+	feeCoins := sdk.NewCoins(sdk.NewCoin("okt", sdk.NewInt(100)))
+
+	if err := collectFeeWithOperatorSplit(ctx, keeper, testAddr(1), operator, feeCoins, "btc_okt"); err == nil {
+		t.Fatal("expected an error from the failed collector transfer, got nil")
+	}
+	if accrued := keeper.GetAccruedOperatorFees(ctx, operator.Address); !accrued.Empty() {
+		t.Errorf("operator must not accrue fees when the collector transfer failed, got %s", accrued)
+	}
+}
+
+func TestHandleMsgCreateOperator_SplitsRegistrationFee(t *testing.T) {
+	ctx := newTestContext()
+	keeper := newMockKeeper()
+	keeper.params.OperatorFeeShare = sdk.NewDecWithPrec(5, 1) // 50%
+
+	owner := testAddr(1)
+	handlingFeeAddr := testAddr(2)
+	msg := MsgCreateOperator{Owner: owner, HandlingFeeAddress: handlingFeeAddr}
+
+	if _, err := handleMsgCreateOperator(ctx, keeper, msg, ctx.Logger()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	registerFee := keeper.params.RegisterOperatorFee.ToCoins()
+	wantOperatorShare := sdk.NewDecCoinsFromCoins(registerFee...).MulDec(sdk.NewDecWithPrec(5, 1)).TruncateDecimal()
+
+	accrued := keeper.GetAccruedOperatorFees(ctx, owner)
+	if !accrued.IsEqual(wantOperatorShare) {
+		t.Errorf("operator accrued %s of the registration fee, want %s", accrued, wantOperatorShare)
+	}
+	if accrued.Empty() {
+		t.Fatal("handleMsgCreateOperator must route part of the registration fee to the operator, got nothing")
+	}
+}
+
+func TestHandleMsgListMini_SplitsListingFee(t *testing.T) {
+	ctx := newTestContext()
+	keeper, owner := setupListingKeeper(t)
+	keeper.params.OperatorFeeShare = sdk.NewDecWithPrec(3, 1) // 30%
+
+	msg := MsgListMini{Owner: owner, ListAsset: "btc", QuoteAsset: "okt", InitPrice: sdk.OneDec(), MaxSupply: sdk.NewDec(1000)}
+	if _, err := handleMsgListMini(ctx, keeper, msg, ctx.Logger()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	listMiniFee := keeper.params.ListMiniFee.ToCoins()
+	wantOperatorShare := sdk.NewDecCoinsFromCoins(listMiniFee...).MulDec(sdk.NewDecWithPrec(3, 1)).TruncateDecimal()
+
+	accrued := keeper.GetAccruedOperatorFees(ctx, owner)
+	if accrued.Empty() {
+		t.Fatal("handleMsgListMini must route part of the listing fee to the operator, got nothing")
+	}
+	if !accrued.IsEqual(wantOperatorShare) {
+		t.Errorf("operator accrued %s of the mini listing fee, want %s", accrued, wantOperatorShare)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/okex/okexchain/x/dex/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -28,6 +29,11 @@ func NewHandler(k IKeeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgList(ctx, k, msg, logger)
 			}
+		case MsgListMini:
+			name = "handleMsgListMini"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgListMini(ctx, k, msg, logger)
+			}
 		case MsgDeposit:
 			name = "handleMsgDeposit"
 			handlerFun = func() (*sdk.Result, error) {
@@ -58,9 +64,39 @@ func NewHandler(k IKeeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgUpdateOperator(ctx, k, msg, logger)
 			}
+		case MsgPauseOperator:
+			name = "handleMsgPauseOperator"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgPauseOperator(ctx, k, msg, logger)
+			}
+		case MsgBlockAddress:
+			name = "handleMsgBlockAddress"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgBlockAddress(ctx, k, msg, logger)
+			}
+		case MsgUnblockAddress:
+			name = "handleMsgUnblockAddress"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgUnblockAddress(ctx, k, msg, logger)
+			}
+		case MsgClaimOperatorFees:
+			name = "handleMsgClaimOperatorFees"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgClaimOperatorFees(ctx, k, msg, logger)
+			}
+		case MsgProposeTokenPairUpdate:
+			name = "handleMsgProposeTokenPairUpdate"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgProposeTokenPairUpdate(ctx, k, msg, logger)
+			}
+		case MsgConfirmTokenPairUpdate:
+			name = "handleMsgConfirmTokenPairUpdate"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgConfirmTokenPairUpdate(ctx, k, msg, logger)
+			}
 		default:
 			errMsg := fmt.Sprintf("unrecognized dex message type: %T", msg)
-			return sdk.ErrUnknownRequest(errMsg).Result()
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
 		}
 
 		seq := perf.GetPerf().OnDeliverTxEnter(ctx, ModuleName, name)
@@ -72,15 +108,59 @@ func NewHandler(k IKeeper) sdk.Handler {
 	}
 }
 
+// collectFeeWithOperatorSplit deducts feeCoins from payer, routing OperatorFeeShare of it
+// to operator's HandlingFeeAddress (accrued in the dex module account until claimed via
+// MsgClaimOperatorFees) and the remainder to the module's fee collector.
+func collectFeeWithOperatorSplit(ctx sdk.Context, keeper IKeeper, payer sdk.AccAddress,
+	operator types.DEXOperator, feeCoins sdk.Coins, product string) error {
+
+	operatorShare := keeper.GetParams(ctx).OperatorFeeShare
+	operatorCoins := sdk.NewDecCoinsFromCoins(feeCoins...).MulDec(operatorShare).TruncateDecimal()
+	collectorCoins := feeCoins.Sub(operatorCoins)
+
+	if collectorCoins.IsValid() && !collectorCoins.Empty() {
+		if err := keeper.GetSupplyKeeper().SendCoinsFromAccountToModule(ctx, payer, keeper.GetFeeCollector(), collectorCoins); err != nil {
+			return err
+		}
+	}
+	if operatorCoins.IsValid() && !operatorCoins.Empty() {
+		if err := keeper.GetSupplyKeeper().SendCoinsFromAccountToModule(ctx, payer, ModuleName, operatorCoins); err != nil {
+			return err
+		}
+		keeper.AccrueOperatorFees(ctx, operator.Address, operatorCoins)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOperatorFeePaid,
+				sdk.NewAttribute(types.AttributeKeyRecipient, operator.HandlingFeeAddress.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, operatorCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyProduct, product),
+			),
+		)
+	}
+	return nil
+}
+
 func handleMsgList(ctx sdk.Context, keeper IKeeper, msg MsgList, logger log.Logger) (*sdk.Result, error) {
 
 	if !keeper.GetTokenKeeper().TokenExist(ctx, msg.ListAsset) ||
 		!keeper.GetTokenKeeper().TokenExist(ctx, msg.QuoteAsset) {
-		return nil, types.ErrTokenPairExisted(msg.ListAsset, msg.QuoteAsset)
+		return nil, sdkerrors.Wrapf(types.ErrUnknownRequest, "token %s or %s does not exist", msg.ListAsset, msg.QuoteAsset)
 	}
 
-	if _, exists := keeper.GetOperator(ctx, msg.Owner); !exists {
-		return nil, types.ErrUnknownOperator(msg.Owner)
+	operator, exists := keeper.GetOperator(ctx, msg.Owner)
+	if !exists {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Owner.String())
+	}
+	if operator.Paused {
+		return nil, sdkerrors.Wrap(types.ErrOperatorPaused, operator.Address.String())
+	}
+	if operator.RateLimit.Active {
+		window := keeper.GetCurrentListCount(ctx, operator.Address)
+		if window.Count.GTE(operator.RateLimit.Limit) {
+			return nil, sdkerrors.Wrapf(types.ErrListRateLimitExceeded, "operator %s, limit %s per %s",
+				operator.Address.String(), operator.RateLimit.Limit.String(), operator.RateLimit.Period.String())
+		}
 	}
 
 	tokenPair := &TokenPair{
@@ -94,25 +174,28 @@ func handleMsgList(ctx sdk.Context, keeper IKeeper, msg MsgList, logger log.Logg
 		Delisting:        false,
 		Deposits:         DefaultTokenPairDeposit,
 		BlockHeight:      ctx.BlockHeight(),
+		Kind:             TokenPairKindStandard,
 	}
 
 	// check whether a specific token pair exists with the symbols of base asset and quote asset
 	// Note: aaa_bbb and bbb_aaa are actually one token pair
 	if keeper.GetTokenPair(ctx, fmt.Sprintf("%s_%s", tokenPair.BaseAssetSymbol, tokenPair.QuoteAssetSymbol)) != nil ||
 		keeper.GetTokenPair(ctx, fmt.Sprintf("%s_%s", tokenPair.QuoteAssetSymbol, tokenPair.BaseAssetSymbol)) != nil {
-		return nil, types.ErrTokenPairExisted(tokenPair.BaseAssetSymbol, tokenPair.QuoteAssetSymbol)
+		return nil, sdkerrors.Wrapf(types.ErrDuplicateTokenPair, "%s_%s", tokenPair.BaseAssetSymbol, tokenPair.QuoteAssetSymbol)
 	}
 
-	// deduction fee
+	// deduction fee, split between the fee collector and the listing operator
 	feeCoins := keeper.GetParams(ctx).ListFee.ToCoins()
-	err := keeper.GetSupplyKeeper().SendCoinsFromAccountToModule(ctx, msg.Owner, keeper.GetFeeCollector(), feeCoins)
-	if err != nil {
-		return nil, types.ErrInsufficientFeeCoins(feeCoins.String())
+	if err := collectFeeWithOperatorSplit(ctx, keeper, msg.Owner, operator, feeCoins, tokenPair.Name()); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInsufficientFee, feeCoins.String())
 	}
 
 	err2 := keeper.SaveTokenPair(ctx, tokenPair)
 	if err2 != nil {
-		return nil, types.ErrTokenPairSaveFailed(err2.Error())
+		return nil, sdkerrors.Wrap(types.ErrTokenPairSaveFailed, err2.Error())
+	}
+	if operator.RateLimit.Active {
+		keeper.IncrementListCount(ctx, operator.Address)
 	}
 
 	logger.Debug(fmt.Sprintf("successfully handleMsgList: "+
@@ -135,11 +218,129 @@ func handleMsgList(ctx sdk.Context, keeper IKeeper, msg MsgList, logger log.Logg
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+// handleMsgListMini lists a lightweight "mini" token pair: a smaller listing fee
+// in exchange for a capped supply, a tighter minimum trade size and a restricted
+// quote-asset whitelist, analogous to BEP8 mini-tokens on bnb-chain.
+func handleMsgListMini(ctx sdk.Context, keeper IKeeper, msg MsgListMini, logger log.Logger) (*sdk.Result, error) {
+
+	if !keeper.GetTokenKeeper().TokenExist(ctx, msg.ListAsset) ||
+		!keeper.GetTokenKeeper().TokenExist(ctx, msg.QuoteAsset) {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownRequest, "token %s or %s does not exist", msg.ListAsset, msg.QuoteAsset)
+	}
+
+	operator, exists := keeper.GetOperator(ctx, msg.Owner)
+	if !exists {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Owner.String())
+	}
+	if operator.Paused {
+		return nil, sdkerrors.Wrap(types.ErrOperatorPaused, operator.Address.String())
+	}
+	if operator.RateLimit.Active {
+		window := keeper.GetCurrentListCount(ctx, operator.Address)
+		if window.Count.GTE(operator.RateLimit.Limit) {
+			return nil, sdkerrors.Wrapf(types.ErrListRateLimitExceeded, "operator %s, limit %s per %s",
+				operator.Address.String(), operator.RateLimit.Limit.String(), operator.RateLimit.Period.String())
+		}
+	}
+
+	params := keeper.GetParams(ctx)
+
+	whitelisted := false
+	for _, quote := range params.MiniQuoteAssetWhitelist {
+		if quote == msg.QuoteAsset {
+			whitelisted = true
+			break
+		}
+	}
+	if !whitelisted {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownRequest, "quote asset %s is not allowed for mini pairs", msg.QuoteAsset)
+	}
+
+	if msg.MaxSupply.GT(params.MiniMaxSupply) {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownRequest, "max supply %s exceeds the mini pair cap of %s",
+			msg.MaxSupply.String(), params.MiniMaxSupply.String())
+	}
+
+	tokenPair := &TokenPair{
+		BaseAssetSymbol:  msg.ListAsset,
+		QuoteAssetSymbol: msg.QuoteAsset,
+		InitPrice:        msg.InitPrice,
+		MaxPriceDigit:    int64(DefaultMaxPriceDigitSize),
+		MaxQuantityDigit: int64(DefaultMaxQuantityDigitSize),
+		MinQuantity:      params.MiniMinQuantity,
+		Owner:            msg.Owner,
+		Delisting:        false,
+		Deposits:         DefaultTokenPairDeposit,
+		BlockHeight:      ctx.BlockHeight(),
+		Kind:             TokenPairKindMini,
+		MaxSupply:        msg.MaxSupply,
+	}
+
+	// a symbol pair may only be listed once, regardless of which kind claims it first
+	existing := keeper.GetTokenPair(ctx, fmt.Sprintf("%s_%s", tokenPair.BaseAssetSymbol, tokenPair.QuoteAssetSymbol))
+	if existing == nil {
+		existing = keeper.GetTokenPair(ctx, fmt.Sprintf("%s_%s", tokenPair.QuoteAssetSymbol, tokenPair.BaseAssetSymbol))
+	}
+	if existing != nil {
+		if existing.Kind != TokenPairKindMini {
+			return nil, sdkerrors.Wrapf(types.ErrTokenPairKindCollision, "%s_%s", tokenPair.BaseAssetSymbol, tokenPair.QuoteAssetSymbol)
+		}
+		return nil, sdkerrors.Wrapf(types.ErrDuplicateTokenPair, "%s_%s", tokenPair.BaseAssetSymbol, tokenPair.QuoteAssetSymbol)
+	}
+
+	// deduction fee, split between the fee collector and the listing operator
+	feeCoins := params.ListMiniFee.ToCoins()
+	if err := collectFeeWithOperatorSplit(ctx, keeper, msg.Owner, operator, feeCoins, tokenPair.Name()); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInsufficientFee, feeCoins.String())
+	}
+
+	err2 := keeper.SaveTokenPair(ctx, tokenPair)
+	if err2 != nil {
+		return nil, sdkerrors.Wrap(types.ErrTokenPairSaveFailed, err2.Error())
+	}
+	if operator.RateLimit.Active {
+		keeper.IncrementListCount(ctx, operator.Address)
+	}
+
+	logger.Debug(fmt.Sprintf("successfully handleMsgListMini: "+
+		"BlockHeight: %d, Msg: %+v", ctx.BlockHeight(), msg))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"list_mini",
+			sdk.NewAttribute("list-asset", tokenPair.BaseAssetSymbol),
+			sdk.NewAttribute("quote-asset", tokenPair.QuoteAssetSymbol),
+			sdk.NewAttribute("init-price", tokenPair.InitPrice.String()),
+			sdk.NewAttribute("max-supply", msg.MaxSupply.String()),
+			sdk.NewAttribute("min-trade-size", tokenPair.MinQuantity.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFee, feeCoins.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgDeposit(ctx sdk.Context, keeper IKeeper, msg MsgDeposit, logger log.Logger) (*sdk.Result, error) {
 	confirmOwnership, exist := keeper.GetConfirmOwnership(ctx, msg.Product)
 	if exist && !ctx.BlockTime().After(confirmOwnership.Expire) {
-		return nil, types.ErrInternal(msg.Product)
+		return nil, sdkerrors.Wrapf(types.ErrPendingChangeConflict, "ownership transfer of %s awaiting confirmation", msg.Product)
+	}
+	if keeper.HasPendingChange(ctx, msg.Product) {
+		return nil, sdkerrors.Wrap(types.ErrPendingChangeConflict, msg.Product)
 	}
+
+	tokenPair := keeper.GetTokenPair(ctx, msg.Product)
+	if tokenPair != nil {
+		if operator, isOperator := keeper.GetOperator(ctx, tokenPair.Owner); isOperator {
+			if operator.Paused {
+				return nil, sdkerrors.Wrap(types.ErrOperatorPaused, operator.Address.String())
+			}
+			if operator.IsBlocked(msg.Depositor) {
+				return nil, sdkerrors.Wrap(types.ErrAddressBlocked, msg.Depositor.String())
+			}
+		}
+	}
+
 	if sdkErr := keeper.Deposit(ctx, msg.Product, msg.Depositor, msg.Amount); sdkErr != nil {
 		return nil, sdkErr
 	}
@@ -159,6 +360,9 @@ func handleMsgDeposit(ctx sdk.Context, keeper IKeeper, msg MsgDeposit, logger lo
 }
 
 func handleMsgWithDraw(ctx sdk.Context, keeper IKeeper, msg MsgWithdraw, logger log.Logger) (*sdk.Result, error) {
+	if keeper.HasPendingChange(ctx, msg.Product) {
+		return nil, sdkerrors.Wrap(types.ErrPendingChangeConflict, msg.Product)
+	}
 	if sdkErr := keeper.Withdraw(ctx, msg.Product, msg.Depositor, msg.Amount); sdkErr != nil {
 		return nil, sdkErr
 	}
@@ -181,31 +385,31 @@ func handleMsgTransferOwnership(ctx sdk.Context, keeper IKeeper, msg MsgTransfer
 	// validate
 	tokenPair := keeper.GetTokenPair(ctx, msg.Product)
 	if tokenPair == nil {
-		return nil, types.ErrTokenPairNotFound(fmt.Sprintf("non-exist product: %s", msg.Product))
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "product: %s", msg.Product)
 	}
 	if !tokenPair.Owner.Equals(msg.FromAddress) {
-		return nil, types.ErrUnauthorized(msg.FromAddress.String())
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedCaller, msg.FromAddress.String())
 	}
 	if _, exist := keeper.GetOperator(ctx, msg.ToAddress); !exist {
-		return nil, types.ErrUnknownOperator(msg.ToAddress)
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.ToAddress.String())
 	}
 	confirmOwnership, exist := keeper.GetConfirmOwnership(ctx, msg.Product)
 	if exist && !ctx.BlockTime().After(confirmOwnership.Expire) {
-		return nil, types.ErrInternal(msg.Product)
+		return nil, sdkerrors.Wrapf(types.ErrPendingChangeConflict, "ownership transfer of %s awaiting confirmation", msg.Product)
 	}
 
 	// withdraw
 	if tokenPair.Deposits.IsPositive() {
 		if err := keeper.Withdraw(ctx, msg.Product, msg.FromAddress, tokenPair.Deposits); err != nil {
-			return nil, types.ErrInternal(err.Error())
+			return nil, sdkerrors.Wrap(types.ErrInternal, err.Error())
 		}
 	}
 
-	// deduction fee
+	// deduction fee, split between the fee collector and the operator taking ownership
+	newOperator, _ := keeper.GetOperator(ctx, msg.ToAddress)
 	feeCoins := keeper.GetParams(ctx).TransferOwnershipFee.ToCoins()
-	err := keeper.GetSupplyKeeper().SendCoinsFromAccountToModule(ctx, msg.FromAddress, keeper.GetFeeCollector(), feeCoins)
-	if err != nil {
-		return nil, types.ErrInsufficientCoins(feeCoins.String())
+	if err := collectFeeWithOperatorSplit(ctx, keeper, msg.FromAddress, newOperator, feeCoins, msg.Product); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInsufficientCoins, feeCoins.String())
 	}
 
 	// set ConfirmOwnership
@@ -234,20 +438,20 @@ func handleMsgTransferOwnership(ctx sdk.Context, keeper IKeeper, msg MsgTransfer
 func handleMsgConfirmOwnership(ctx sdk.Context, keeper IKeeper, msg MsgConfirmOwnership, logger log.Logger) (*sdk.Result, error) {
 	confirmOwnership, exist := keeper.GetConfirmOwnership(ctx, msg.Product)
 	if !exist {
-		return nil, types.ErrUnknownRequest(fmt.Sprintf("no transfer-ownership of list (%s) to confirm", msg.Address.String()))
+		return nil, sdkerrors.Wrapf(types.ErrUnknownRequest, "no transfer-ownership of list (%s) to confirm", msg.Address.String())
 	}
 	if ctx.BlockTime().After(confirmOwnership.Expire) {
 		// delete ownership confirming information
 		keeper.DeleteConfirmOwnership(ctx, confirmOwnership.Product)
-		return nil, types.ErrInternal(confirmOwnership.Expire.String())
+		return nil, sdkerrors.Wrap(types.ErrOwnershipConfirmExpired, confirmOwnership.Expire.String())
 	}
 	if !confirmOwnership.ToAddress.Equals(msg.Address) {
-		return nil, types.ErrUnauthorized(confirmOwnership.ToAddress.String())
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedCaller, confirmOwnership.ToAddress.String())
 	}
 
 	tokenPair := keeper.GetTokenPair(ctx, msg.Product)
 	if tokenPair == nil {
-		return nil, types.ErrTokenPairNotFound(fmt.Sprintf("non-exist product: %s", msg.Product))
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "product: %s", msg.Product)
 	}
 	// transfer ownership
 	tokenPair.Owner = msg.Address
@@ -273,7 +477,7 @@ func handleMsgCreateOperator(ctx sdk.Context, keeper IKeeper, msg MsgCreateOpera
 	logger.Debug(fmt.Sprintf("handleMsgCreateOperator msg: %+v", msg))
 
 	if _, isExist := keeper.GetOperator(ctx, msg.Owner); isExist {
-		return nil, types.ErrExistOperator(msg.Owner)
+		return nil, sdkerrors.Wrap(types.ErrOperatorExists, msg.Owner.String())
 	}
 	operator := types.DEXOperator{
 		Address:            msg.Owner,
@@ -281,14 +485,16 @@ func handleMsgCreateOperator(ctx sdk.Context, keeper IKeeper, msg MsgCreateOpera
 		Website:            msg.Website,
 		InitHeight:         ctx.BlockHeight(),
 		TxHash:             fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())),
+		Blockable:          msg.Blockable,
+		RateLimit:          msg.RateLimit,
 	}
 	keeper.SetOperator(ctx, operator)
 
-	// deduction fee
+	// deduction fee, split between the fee collector and the operator's own
+	// handling fee address, same as a listing or ownership transfer fee
 	feeCoins := keeper.GetParams(ctx).RegisterOperatorFee.ToCoins()
-	err := keeper.GetSupplyKeeper().SendCoinsFromAccountToModule(ctx, msg.Owner, keeper.GetFeeCollector(), feeCoins)
-	if err != nil {
-		return nil, types.ErrInsufficientCoins(feeCoins.String())
+	if err := collectFeeWithOperatorSplit(ctx, keeper, msg.Owner, operator, feeCoins, ""); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInsufficientCoins, feeCoins.String())
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -308,14 +514,16 @@ func handleMsgUpdateOperator(ctx sdk.Context, keeper IKeeper, msg MsgUpdateOpera
 
 	operator, isExist := keeper.GetOperator(ctx, msg.Owner)
 	if !isExist {
-		return nil, types.ErrUnknownOperator(msg.Owner)
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Owner.String())
 	}
 	if !operator.Address.Equals(msg.Owner) {
-		return nil, types.ErrUnauthorized(operator.Address.String())
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedCaller, operator.Address.String())
 	}
 
 	operator.HandlingFeeAddress = msg.HandlingFeeAddress
 	operator.Website = msg.Website
+	operator.Blockable = msg.Blockable
+	operator.RateLimit = msg.RateLimit
 
 	keeper.SetOperator(ctx, operator)
 
@@ -328,3 +536,202 @@ func handleMsgUpdateOperator(ctx sdk.Context, keeper IKeeper, msg MsgUpdateOpera
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgPauseOperator(ctx sdk.Context, keeper IKeeper, msg MsgPauseOperator, logger log.Logger) (*sdk.Result, error) {
+	operator, isExist := keeper.GetOperator(ctx, msg.Owner)
+	if !isExist {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Owner.String())
+	}
+	if !operator.Address.Equals(msg.Owner) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedCaller, operator.Address.String())
+	}
+
+	operator.Paused = msg.Paused
+	keeper.SetOperator(ctx, operator)
+
+	logger.Debug(fmt.Sprintf("successfully handleMsgPauseOperator: "+
+		"BlockHeight: %d, Msg: %+v", ctx.BlockHeight(), msg))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute("operator", operator.Address.String()),
+			sdk.NewAttribute("paused", fmt.Sprintf("%t", operator.Paused)),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgBlockAddress(ctx sdk.Context, keeper IKeeper, msg MsgBlockAddress, logger log.Logger) (*sdk.Result, error) {
+	operator, isExist := keeper.GetOperator(ctx, msg.Owner)
+	if !isExist {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Owner.String())
+	}
+	if !operator.Address.Equals(msg.Owner) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedCaller, operator.Address.String())
+	}
+	if !operator.Blockable {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorizedCaller, "operator %s has not opted into blocking addresses", operator.Address.String())
+	}
+
+	if !operator.IsBlocked(msg.Address) {
+		operator.BlockedAddresses = append(operator.BlockedAddresses, msg.Address)
+		keeper.SetOperator(ctx, operator)
+	}
+
+	logger.Debug(fmt.Sprintf("successfully handleMsgBlockAddress: "+
+		"BlockHeight: %d, Msg: %+v", ctx.BlockHeight(), msg))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute("operator", operator.Address.String()),
+			sdk.NewAttribute("blocked-address", msg.Address.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUnblockAddress(ctx sdk.Context, keeper IKeeper, msg MsgUnblockAddress, logger log.Logger) (*sdk.Result, error) {
+	operator, isExist := keeper.GetOperator(ctx, msg.Owner)
+	if !isExist {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Owner.String())
+	}
+	if !operator.Address.Equals(msg.Owner) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedCaller, operator.Address.String())
+	}
+
+	remaining := operator.BlockedAddresses[:0]
+	for _, blocked := range operator.BlockedAddresses {
+		if !blocked.Equals(msg.Address) {
+			remaining = append(remaining, blocked)
+		}
+	}
+	operator.BlockedAddresses = remaining
+	keeper.SetOperator(ctx, operator)
+
+	logger.Debug(fmt.Sprintf("successfully handleMsgUnblockAddress: "+
+		"BlockHeight: %d, Msg: %+v", ctx.BlockHeight(), msg))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute("operator", operator.Address.String()),
+			sdk.NewAttribute("unblocked-address", msg.Address.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgClaimOperatorFees(ctx sdk.Context, keeper IKeeper, msg MsgClaimOperatorFees, logger log.Logger) (*sdk.Result, error) {
+	operator, isExist := keeper.GetOperator(ctx, msg.Owner)
+	if !isExist {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Owner.String())
+	}
+
+	accrued := keeper.GetAccruedOperatorFees(ctx, operator.Address)
+	if accrued.Empty() {
+		return nil, sdkerrors.Wrap(types.ErrNoAccruedOperatorFees, operator.Address.String())
+	}
+
+	if err := keeper.GetSupplyKeeper().SendCoinsFromModuleToAccount(ctx, ModuleName, operator.HandlingFeeAddress, accrued); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInternal, err.Error())
+	}
+	keeper.ClearAccruedOperatorFees(ctx, operator.Address)
+
+	logger.Debug(fmt.Sprintf("successfully handleMsgClaimOperatorFees: "+
+		"BlockHeight: %d, Msg: %+v", ctx.BlockHeight(), msg))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOperatorFeePaid,
+			sdk.NewAttribute(types.AttributeKeyRecipient, operator.HandlingFeeAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, accrued.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgProposeTokenPairUpdate(ctx sdk.Context, keeper IKeeper, msg MsgProposeTokenPairUpdate,
+	logger log.Logger) (*sdk.Result, error) {
+	tokenPair := keeper.GetTokenPair(ctx, msg.Product)
+	if tokenPair == nil {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "product: %s", msg.Product)
+	}
+	if !tokenPair.Owner.Equals(msg.Owner) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedCaller, msg.Owner.String())
+	}
+	if keeper.HasPendingChange(ctx, msg.Product) {
+		return nil, sdkerrors.Wrap(types.ErrPendingChangeConflict, msg.Product)
+	}
+
+	changeID := fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+	expireTime := ctx.BlockTime().Add(keeper.GetParams(ctx).TokenPairUpdateConfirmWindow)
+	pendingChange := &types.PendingChange{
+		Product:        msg.Product,
+		ChangeID:       changeID,
+		ProposedBy:     msg.Owner,
+		ConfirmAddress: msg.ConfirmAddress,
+		Update: types.TokenPairUpdateFields{
+			MaxPriceDigit:    msg.MaxPriceDigit,
+			MaxQuantityDigit: msg.MaxQuantityDigit,
+			MinQuantity:      msg.MinQuantity,
+			InitPrice:        msg.InitPrice,
+		},
+		Expire: expireTime,
+	}
+	keeper.SetPendingChange(ctx, pendingChange)
+
+	logger.Debug(fmt.Sprintf("successfully handleMsgProposeTokenPairUpdate: "+
+		"BlockHeight: %d, Msg: %+v", ctx.BlockHeight(), msg))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenPairUpdateProposed,
+			sdk.NewAttribute(types.AttributeKeyProduct, msg.Product),
+			sdk.NewAttribute(types.AttributeKeyChangeID, changeID),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgConfirmTokenPairUpdate(ctx sdk.Context, keeper IKeeper, msg MsgConfirmTokenPairUpdate,
+	logger log.Logger) (*sdk.Result, error) {
+	pendingChange, exist := keeper.GetPendingChange(ctx, msg.Product, msg.ChangeID)
+	if !exist {
+		return nil, sdkerrors.Wrapf(types.ErrPendingChangeNotFound, "product: %s, change: %s", msg.Product, msg.ChangeID)
+	}
+	if ctx.BlockTime().After(pendingChange.Expire) {
+		keeper.DeletePendingChange(ctx, msg.Product, msg.ChangeID)
+		return nil, sdkerrors.Wrap(types.ErrOwnershipConfirmExpired, pendingChange.Expire.String())
+	}
+	if !pendingChange.ConfirmAddress.Equals(msg.Address) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedCaller, pendingChange.ConfirmAddress.String())
+	}
+
+	tokenPair := keeper.GetTokenPair(ctx, msg.Product)
+	if tokenPair == nil {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "product: %s", msg.Product)
+	}
+	tokenPair.MaxPriceDigit = pendingChange.Update.MaxPriceDigit
+	tokenPair.MaxQuantityDigit = pendingChange.Update.MaxQuantityDigit
+	tokenPair.MinQuantity = pendingChange.Update.MinQuantity
+	tokenPair.InitPrice = pendingChange.Update.InitPrice
+	keeper.UpdateTokenPair(ctx, msg.Product, tokenPair)
+	keeper.DeletePendingChange(ctx, msg.Product, msg.ChangeID)
+
+	logger.Debug(fmt.Sprintf("successfully handleMsgConfirmTokenPairUpdate: "+
+		"BlockHeight: %d, Msg: %+v", ctx.BlockHeight(), msg))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenPairUpdateConfirmed,
+			sdk.NewAttribute(types.AttributeKeyProduct, msg.Product),
+			sdk.NewAttribute(types.AttributeKeyChangeID, msg.ChangeID),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,74 @@
+package dex
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+// TokenKeeper defines the expected behavior the token module's keeper must provide
+type TokenKeeper interface {
+	TokenExist(ctx sdk.Context, symbol string) bool
+}
+
+// SupplyKeeper defines the expected behavior the supply module's keeper must provide
+type SupplyKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// IKeeper defines the behavior the dex keeper must provide to the message handlers
+type IKeeper interface {
+	GetTokenKeeper() TokenKeeper
+	GetSupplyKeeper() SupplyKeeper
+	GetFeeCollector() string
+	GetParams(ctx sdk.Context) types.Params
+
+	GetTokenPair(ctx sdk.Context, product string) *types.TokenPair
+	SaveTokenPair(ctx sdk.Context, tokenPair *types.TokenPair) error
+	UpdateTokenPair(ctx sdk.Context, product string, tokenPair *types.TokenPair)
+
+	GetOperator(ctx sdk.Context, addr sdk.AccAddress) (types.DEXOperator, bool)
+	SetOperator(ctx sdk.Context, operator types.DEXOperator)
+
+	GetConfirmOwnership(ctx sdk.Context, product string) (*types.ConfirmOwnership, bool)
+	SetConfirmOwnership(ctx sdk.Context, confirmOwnership *types.ConfirmOwnership)
+	DeleteConfirmOwnership(ctx sdk.Context, product string)
+	UpdateUserTokenPair(ctx sdk.Context, product string, from, to sdk.AccAddress)
+
+	Deposit(ctx sdk.Context, product string, depositor sdk.AccAddress, amount sdk.DecCoin) sdk.Error
+	Withdraw(ctx sdk.Context, product string, depositor sdk.AccAddress, amount sdk.DecCoin) sdk.Error
+
+	// GetCurrentListCount returns how many pairs the operator has listed in its current
+	// rate-limit window, resetting the window first if it has elapsed
+	GetCurrentListCount(ctx sdk.Context, operator sdk.AccAddress) types.ListCountWindow
+	// IncrementListCount records a new listing against the operator's current window
+	IncrementListCount(ctx sdk.Context, operator sdk.AccAddress)
+
+	// DelistTokenPair marks product as delisting, refunds its deposits to the current
+	// owner and schedules the change to take effect at effectiveHeight
+	DelistTokenPair(ctx sdk.Context, product, reason string, effectiveHeight int64) error
+	// RelistTokenPair reverses a pending or already-applied delisting of product
+	RelistTokenPair(ctx sdk.Context, product, reason string, effectiveHeight int64) error
+	// GetPendingDelistings returns every delisting that has passed governance but has
+	// not yet reached its effective height
+	GetPendingDelistings(ctx sdk.Context) []types.PendingDelisting
+
+	// AccrueOperatorFees records amount as held by the dex module account on behalf of operator
+	AccrueOperatorFees(ctx sdk.Context, operator sdk.AccAddress, amount sdk.Coins)
+	// GetAccruedOperatorFees returns the balance currently held for operator, awaiting claim
+	GetAccruedOperatorFees(ctx sdk.Context, operator sdk.AccAddress) sdk.Coins
+	// ClearAccruedOperatorFees zeroes out operator's accrued fee balance after it is claimed
+	ClearAccruedOperatorFees(ctx sdk.Context, operator sdk.AccAddress)
+
+	// GetPendingChange looks up a specific pending token pair parameter change by its changeID
+	GetPendingChange(ctx sdk.Context, product, changeID string) (*types.PendingChange, bool)
+	// SetPendingChange stores a newly proposed token pair parameter change
+	SetPendingChange(ctx sdk.Context, change *types.PendingChange)
+	// DeletePendingChange removes a pending token pair parameter change once it is
+	// confirmed, expired or superseded
+	DeletePendingChange(ctx sdk.Context, product, changeID string)
+	// HasPendingChange reports whether product has any unconfirmed, unexpired
+	// change awaiting confirmation, regardless of changeID
+	HasPendingChange(ctx sdk.Context, product string) bool
+}
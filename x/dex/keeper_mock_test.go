@@ -0,0 +1,218 @@
+package dex
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+// testAddr returns a deterministic, distinct sdk.AccAddress for use in tests
+func testAddr(b byte) sdk.AccAddress {
+	addr := make(sdk.AccAddress, 20)
+	addr[19] = b
+	return addr
+}
+
+// newTestContext builds the minimal sdk.Context the message handlers need: a
+// block height/time and an event manager, same as NewHandler sets up before
+// dispatching to a handleMsgXxx function. No real multistore is needed since
+// the handlers only ever touch state through the IKeeper they're given.
+func newTestContext() sdk.Context {
+	header := abci.Header{Height: 1, Time: time.Unix(1700000000, 0)}
+	return sdk.NewContext(nil, header, false, log.NewNopLogger()).WithEventManager(sdk.NewEventManager())
+}
+
+// mockTokenKeeper is a test double for TokenKeeper; every symbol it is seeded
+// with reports as existing, everything else does not
+type mockTokenKeeper struct {
+	tokens map[string]bool
+}
+
+func newMockTokenKeeper(symbols ...string) *mockTokenKeeper {
+	tokens := make(map[string]bool, len(symbols))
+	for _, s := range symbols {
+		tokens[s] = true
+	}
+	return &mockTokenKeeper{tokens: tokens}
+}
+
+func (k *mockTokenKeeper) TokenExist(ctx sdk.Context, symbol string) bool {
+	return k.tokens[symbol]
+}
+
+// mockSupplyKeeper is a test double for SupplyKeeper that records every
+// transfer instead of moving real balances, and can be told to fail the next
+// account->module transfer to exercise the insufficient-funds path
+type mockSupplyKeeper struct {
+	sentToModule  []sdk.Coins
+	sentToAccount []sdk.Coins
+	failNext      bool
+}
+
+func (k *mockSupplyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if k.failNext {
+		k.failNext = false
+		return fmt.Errorf("mock supply keeper: insufficient funds")
+	}
+	k.sentToModule = append(k.sentToModule, amt)
+	return nil
+}
+
+func (k *mockSupplyKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	k.sentToAccount = append(k.sentToAccount, amt)
+	return nil
+}
+
+// mockKeeper is an in-memory stand-in for the dex keeper, implementing just
+// enough of IKeeper to drive the message handlers under test without a real store
+type mockKeeper struct {
+	tokenKeeper  *mockTokenKeeper
+	supplyKeeper *mockSupplyKeeper
+	feeCollector string
+	params       types.Params
+
+	tokenPairs        map[string]*types.TokenPair
+	operators         map[string]types.DEXOperator
+	confirmOwnerships map[string]*types.ConfirmOwnership
+	listCounts        map[string]types.ListCountWindow
+	pendingDelistings []types.PendingDelisting
+	accruedFees       map[string]sdk.Coins
+	pendingChanges    map[string]*types.PendingChange
+}
+
+var _ IKeeper = (*mockKeeper)(nil)
+
+func newMockKeeper() *mockKeeper {
+	return &mockKeeper{
+		tokenKeeper:  newMockTokenKeeper(),
+		supplyKeeper: &mockSupplyKeeper{},
+		feeCollector: "fee_collector",
+		params:       types.DefaultParams(),
+
+		tokenPairs:        make(map[string]*types.TokenPair),
+		operators:         make(map[string]types.DEXOperator),
+		confirmOwnerships: make(map[string]*types.ConfirmOwnership),
+		listCounts:        make(map[string]types.ListCountWindow),
+		accruedFees:       make(map[string]sdk.Coins),
+		pendingChanges:    make(map[string]*types.PendingChange),
+	}
+}
+
+func (k *mockKeeper) GetTokenKeeper() TokenKeeper            { return k.tokenKeeper }
+func (k *mockKeeper) GetSupplyKeeper() SupplyKeeper          { return k.supplyKeeper }
+func (k *mockKeeper) GetFeeCollector() string                { return k.feeCollector }
+func (k *mockKeeper) GetParams(ctx sdk.Context) types.Params { return k.params }
+
+func (k *mockKeeper) GetTokenPair(ctx sdk.Context, product string) *types.TokenPair {
+	return k.tokenPairs[product]
+}
+
+func (k *mockKeeper) SaveTokenPair(ctx sdk.Context, tokenPair *types.TokenPair) error {
+	k.tokenPairs[tokenPair.Name()] = tokenPair
+	return nil
+}
+
+func (k *mockKeeper) UpdateTokenPair(ctx sdk.Context, product string, tokenPair *types.TokenPair) {
+	k.tokenPairs[product] = tokenPair
+}
+
+func (k *mockKeeper) GetOperator(ctx sdk.Context, addr sdk.AccAddress) (types.DEXOperator, bool) {
+	op, ok := k.operators[addr.String()]
+	return op, ok
+}
+
+func (k *mockKeeper) SetOperator(ctx sdk.Context, operator types.DEXOperator) {
+	k.operators[operator.Address.String()] = operator
+}
+
+func (k *mockKeeper) GetConfirmOwnership(ctx sdk.Context, product string) (*types.ConfirmOwnership, bool) {
+	c, ok := k.confirmOwnerships[product]
+	return c, ok
+}
+
+func (k *mockKeeper) SetConfirmOwnership(ctx sdk.Context, confirmOwnership *types.ConfirmOwnership) {
+	k.confirmOwnerships[confirmOwnership.Product] = confirmOwnership
+}
+
+func (k *mockKeeper) DeleteConfirmOwnership(ctx sdk.Context, product string) {
+	delete(k.confirmOwnerships, product)
+}
+
+func (k *mockKeeper) UpdateUserTokenPair(ctx sdk.Context, product string, from, to sdk.AccAddress) {}
+
+func (k *mockKeeper) Deposit(ctx sdk.Context, product string, depositor sdk.AccAddress, amount sdk.DecCoin) sdk.Error {
+	return nil
+}
+
+func (k *mockKeeper) Withdraw(ctx sdk.Context, product string, depositor sdk.AccAddress, amount sdk.DecCoin) sdk.Error {
+	return nil
+}
+
+func (k *mockKeeper) GetCurrentListCount(ctx sdk.Context, operator sdk.AccAddress) types.ListCountWindow {
+	w, ok := k.listCounts[operator.String()]
+	if !ok {
+		return types.ListCountWindow{Operator: operator, Count: sdk.ZeroInt(), PeriodStart: ctx.BlockTime()}
+	}
+	return w
+}
+
+func (k *mockKeeper) IncrementListCount(ctx sdk.Context, operator sdk.AccAddress) {
+	w := k.GetCurrentListCount(ctx, operator)
+	w.Count = w.Count.AddRaw(1)
+	k.listCounts[operator.String()] = w
+}
+
+func (k *mockKeeper) DelistTokenPair(ctx sdk.Context, product, reason string, effectiveHeight int64) error {
+	return nil
+}
+
+func (k *mockKeeper) RelistTokenPair(ctx sdk.Context, product, reason string, effectiveHeight int64) error {
+	return nil
+}
+
+func (k *mockKeeper) GetPendingDelistings(ctx sdk.Context) []types.PendingDelisting {
+	return k.pendingDelistings
+}
+
+func (k *mockKeeper) AccrueOperatorFees(ctx sdk.Context, operator sdk.AccAddress, amount sdk.Coins) {
+	k.accruedFees[operator.String()] = k.accruedFees[operator.String()].Add(amount...)
+}
+
+func (k *mockKeeper) GetAccruedOperatorFees(ctx sdk.Context, operator sdk.AccAddress) sdk.Coins {
+	return k.accruedFees[operator.String()]
+}
+
+func (k *mockKeeper) ClearAccruedOperatorFees(ctx sdk.Context, operator sdk.AccAddress) {
+	delete(k.accruedFees, operator.String())
+}
+
+func pendingChangeKey(product, changeID string) string {
+	return product + "/" + changeID
+}
+
+func (k *mockKeeper) GetPendingChange(ctx sdk.Context, product, changeID string) (*types.PendingChange, bool) {
+	c, ok := k.pendingChanges[pendingChangeKey(product, changeID)]
+	return c, ok
+}
+
+func (k *mockKeeper) SetPendingChange(ctx sdk.Context, change *types.PendingChange) {
+	k.pendingChanges[pendingChangeKey(change.Product, change.ChangeID)] = change
+}
+
+func (k *mockKeeper) DeletePendingChange(ctx sdk.Context, product, changeID string) {
+	delete(k.pendingChanges, pendingChangeKey(product, changeID))
+}
+
+func (k *mockKeeper) HasPendingChange(ctx sdk.Context, product string) bool {
+	for _, c := range k.pendingChanges {
+		if c.Product == product {
+			return true
+		}
+	}
+	return false
+}
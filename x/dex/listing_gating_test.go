@@ -0,0 +1,156 @@
+package dex
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+func setupListingKeeper(t *testing.T) (*mockKeeper, sdk.AccAddress) {
+	t.Helper()
+	keeper := newMockKeeper()
+	keeper.tokenKeeper = newMockTokenKeeper("btc", "okt")
+	owner := testAddr(1)
+	keeper.SetOperator(newTestContext(), types.DEXOperator{
+		Address:            owner,
+		HandlingFeeAddress: testAddr(2),
+	})
+	return keeper, owner
+}
+
+func TestHandleMsgList_RejectsPausedOperator(t *testing.T) {
+	ctx := newTestContext()
+	keeper, owner := setupListingKeeper(t)
+	operator, _ := keeper.GetOperator(ctx, owner)
+	operator.Paused = true
+	keeper.SetOperator(ctx, operator)
+
+	msg := MsgList{Owner: owner, ListAsset: "btc", QuoteAsset: "okt", InitPrice: sdk.OneDec()}
+	if _, err := handleMsgList(ctx, keeper, msg, ctx.Logger()); err == nil {
+		t.Fatal("expected a paused operator to be rejected, got nil error")
+	}
+	if keeper.GetTokenPair(ctx, "btc_okt") != nil {
+		t.Error("a rejected listing must not be saved")
+	}
+}
+
+func TestHandleMsgList_RejectsRateLimitedOperator(t *testing.T) {
+	ctx := newTestContext()
+	keeper, owner := setupListingKeeper(t)
+	operator, _ := keeper.GetOperator(ctx, owner)
+	operator.RateLimit = types.RateLimit{Active: true, Limit: sdk.NewInt(1), Period: time.Hour}
+	keeper.SetOperator(ctx, operator)
+	keeper.listCounts[owner.String()] = types.ListCountWindow{Operator: owner, Count: sdk.NewInt(1), PeriodStart: ctx.BlockTime()}
+
+	msg := MsgList{Owner: owner, ListAsset: "btc", QuoteAsset: "okt", InitPrice: sdk.OneDec()}
+	if _, err := handleMsgList(ctx, keeper, msg, ctx.Logger()); err == nil {
+		t.Fatal("expected an operator at its rate limit to be rejected, got nil error")
+	}
+}
+
+func TestHandleMsgList_IncrementsListCountWhenRateLimited(t *testing.T) {
+	ctx := newTestContext()
+	keeper, owner := setupListingKeeper(t)
+	operator, _ := keeper.GetOperator(ctx, owner)
+	operator.RateLimit = types.RateLimit{Active: true, Limit: sdk.NewInt(2), Period: time.Hour}
+	keeper.SetOperator(ctx, operator)
+
+	msg := MsgList{Owner: owner, ListAsset: "btc", QuoteAsset: "okt", InitPrice: sdk.OneDec()}
+	if _, err := handleMsgList(ctx, keeper, msg, ctx.Logger()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	window := keeper.GetCurrentListCount(ctx, owner)
+	if !window.Count.Equal(sdk.NewInt(1)) {
+		t.Errorf("list count = %s, want 1", window.Count)
+	}
+}
+
+func TestHandleMsgListMini_RejectsPausedOperator(t *testing.T) {
+	ctx := newTestContext()
+	keeper, owner := setupListingKeeper(t)
+	operator, _ := keeper.GetOperator(ctx, owner)
+	operator.Paused = true
+	keeper.SetOperator(ctx, operator)
+
+	msg := MsgListMini{Owner: owner, ListAsset: "btc", QuoteAsset: "okt", InitPrice: sdk.OneDec(), MaxSupply: sdk.NewDec(1000)}
+	if _, err := handleMsgListMini(ctx, keeper, msg, ctx.Logger()); err == nil {
+		t.Fatal("expected a paused operator to be rejected, got nil error")
+	}
+}
+
+func TestHandleMsgListMini_RejectsRateLimitedOperator(t *testing.T) {
+	ctx := newTestContext()
+	keeper, owner := setupListingKeeper(t)
+	operator, _ := keeper.GetOperator(ctx, owner)
+	operator.RateLimit = types.RateLimit{Active: true, Limit: sdk.NewInt(1), Period: time.Hour}
+	keeper.SetOperator(ctx, operator)
+	keeper.listCounts[owner.String()] = types.ListCountWindow{Operator: owner, Count: sdk.NewInt(1), PeriodStart: ctx.BlockTime()}
+
+	msg := MsgListMini{Owner: owner, ListAsset: "btc", QuoteAsset: "okt", InitPrice: sdk.OneDec(), MaxSupply: sdk.NewDec(1000)}
+	if _, err := handleMsgListMini(ctx, keeper, msg, ctx.Logger()); err == nil {
+		t.Fatal("expected an operator at its rate limit to be rejected, got nil error")
+	}
+}
+
+func TestHandleMsgListMini_IncrementsListCountAndPersistsMaxSupply(t *testing.T) {
+	ctx := newTestContext()
+	keeper, owner := setupListingKeeper(t)
+	operator, _ := keeper.GetOperator(ctx, owner)
+	operator.RateLimit = types.RateLimit{Active: true, Limit: sdk.NewInt(2), Period: time.Hour}
+	keeper.SetOperator(ctx, operator)
+
+	maxSupply := sdk.NewDec(1000)
+	msg := MsgListMini{Owner: owner, ListAsset: "btc", QuoteAsset: "okt", InitPrice: sdk.OneDec(), MaxSupply: maxSupply}
+	if _, err := handleMsgListMini(ctx, keeper, msg, ctx.Logger()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	window := keeper.GetCurrentListCount(ctx, owner)
+	if !window.Count.Equal(sdk.NewInt(1)) {
+		t.Errorf("list count = %s, want 1", window.Count)
+	}
+
+	pair := keeper.GetTokenPair(ctx, "btc_okt")
+	if pair == nil {
+		t.Fatal("expected the mini pair to be saved")
+	}
+	if !pair.MaxSupply.Equal(maxSupply) {
+		t.Errorf("token pair MaxSupply = %s, want %s", pair.MaxSupply, maxSupply)
+	}
+}
+
+func TestValidateRateLimit_RejectsActiveWithZeroLimitOrPeriod(t *testing.T) {
+	cases := []struct {
+		name string
+		r    types.RateLimit
+	}{
+		{"nil limit", types.RateLimit{Active: true, Period: time.Hour}},
+		{"zero period", types.RateLimit{Active: true, Limit: sdk.NewInt(10), Period: 0}},
+		{"zero limit", types.RateLimit{Active: true, Limit: sdk.ZeroInt(), Period: time.Hour}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			msg := MsgCreateOperator{Owner: testAddr(1), HandlingFeeAddress: testAddr(2), RateLimit: c.r}
+			if err := msg.ValidateBasic(); err == nil {
+				t.Fatalf("expected %s to be rejected, got nil error", c.name)
+			}
+		})
+	}
+}
+
+func TestValidateRateLimit_AllowsInactiveOrWellFormedActive(t *testing.T) {
+	cases := []types.RateLimit{
+		{Active: false},
+		{Active: true, Limit: sdk.NewInt(10), Period: time.Hour},
+	}
+	for _, r := range cases {
+		msg := MsgCreateOperator{Owner: testAddr(1), HandlingFeeAddress: testAddr(2), RateLimit: r}
+		if err := msg.ValidateBasic(); err != nil {
+			t.Errorf("expected rate limit %+v to be valid, got %v", r, err)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package dex
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+// RegisterCodec registers the dex module's messages on the application-wide
+// codec. The app's MakeCodec is expected to call this alongside every other
+// module's RegisterCodec, the same way it already must for x/bank, x/gov, etc.
+func RegisterCodec(cdc *codec.Codec) {
+	types.RegisterCodec(cdc)
+}
+
+// RegisterProposalRoute wires the dex module's gov Content handler into the
+// chain's gov router, so DelistProposal/RelistProposal submissions are routed
+// to NewProposalHandler instead of being rejected as an unrecognized content
+// type. The app's NewApp constructor is expected to call this while it
+// assembles its govtypes.Router, e.g.:
+//
+//	govRouter := govtypes.NewRouter()
+//	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
+//		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+//	govRouter = dex.RegisterProposalRoute(govRouter, app.DexKeeper)
+func RegisterProposalRoute(router govtypes.Router, k IKeeper) govtypes.Router {
+	return router.AddRoute(RouterKey, NewProposalHandler(k))
+}
@@ -0,0 +1,142 @@
+package dex
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+func setupPendingChangeKeeper(t *testing.T) (*mockKeeper, *types.TokenPair) {
+	t.Helper()
+	keeper := newMockKeeper()
+	pair := &types.TokenPair{
+		BaseAssetSymbol:  "btc",
+		QuoteAssetSymbol: "okt",
+		InitPrice:        sdk.OneDec(),
+		MaxPriceDigit:    8,
+		MaxQuantityDigit: 8,
+		MinQuantity:      sdk.MustNewDecFromStr("0.00000001"),
+		Owner:            testAddr(1),
+		Kind:             TokenPairKindStandard,
+	}
+	keeper.tokenPairs[pair.Name()] = pair
+	return keeper, pair
+}
+
+func TestHandleMsgProposeTokenPairUpdate_RejectsSecondProposalWhilePending(t *testing.T) {
+	ctx := newTestContext()
+	keeper, pair := setupPendingChangeKeeper(t)
+
+	propose := MsgProposeTokenPairUpdate{
+		Owner: pair.Owner, Product: pair.Name(), ConfirmAddress: testAddr(2),
+		MaxPriceDigit: 9, MaxQuantityDigit: 9, MinQuantity: sdk.OneDec(), InitPrice: sdk.NewDec(2),
+	}
+	if _, err := handleMsgProposeTokenPairUpdate(ctx, keeper, propose, ctx.Logger()); err != nil {
+		t.Fatalf("unexpected error on first proposal: %v", err)
+	}
+	if _, err := handleMsgProposeTokenPairUpdate(ctx, keeper, propose, ctx.Logger()); err == nil {
+		t.Fatal("expected a second proposal for the same product to be rejected while one is pending")
+	}
+}
+
+func TestHandleMsgConfirmTokenPairUpdate_AppliesChangeAndClearsPending(t *testing.T) {
+	ctx := newTestContext()
+	keeper, pair := setupPendingChangeKeeper(t)
+	confirmAddr := testAddr(2)
+
+	propose := MsgProposeTokenPairUpdate{
+		Owner: pair.Owner, Product: pair.Name(), ConfirmAddress: confirmAddr,
+		MaxPriceDigit: 9, MaxQuantityDigit: 9, MinQuantity: sdk.NewDec(2), InitPrice: sdk.NewDec(3),
+	}
+	if _, err := handleMsgProposeTokenPairUpdate(ctx, keeper, propose, ctx.Logger()); err != nil {
+		t.Fatalf("unexpected error proposing the change: %v", err)
+	}
+
+	var changeID string
+	for _, c := range keeper.pendingChanges {
+		changeID = c.ChangeID
+	}
+	if changeID == "" {
+		t.Fatal("expected a pending change to have been recorded")
+	}
+
+	confirm := MsgConfirmTokenPairUpdate{Address: confirmAddr, Product: pair.Name(), ChangeID: changeID}
+	if _, err := handleMsgConfirmTokenPairUpdate(ctx, keeper, confirm, ctx.Logger()); err != nil {
+		t.Fatalf("unexpected error confirming the change: %v", err)
+	}
+
+	updated := keeper.GetTokenPair(ctx, pair.Name())
+	if updated.MaxPriceDigit != 9 || updated.MaxQuantityDigit != 9 {
+		t.Errorf("digits = (%d, %d), want (9, 9)", updated.MaxPriceDigit, updated.MaxQuantityDigit)
+	}
+	if !updated.MinQuantity.Equal(sdk.NewDec(2)) || !updated.InitPrice.Equal(sdk.NewDec(3)) {
+		t.Errorf("min quantity/init price = (%s, %s), want (2, 3)", updated.MinQuantity, updated.InitPrice)
+	}
+	if keeper.HasPendingChange(ctx, pair.Name()) {
+		t.Error("confirming a change must clear it from pending")
+	}
+}
+
+func TestHandleMsgConfirmTokenPairUpdate_RejectsWrongConfirmAddress(t *testing.T) {
+	ctx := newTestContext()
+	keeper, pair := setupPendingChangeKeeper(t)
+
+	propose := MsgProposeTokenPairUpdate{
+		Owner: pair.Owner, Product: pair.Name(), ConfirmAddress: testAddr(2),
+		MaxPriceDigit: 9, MaxQuantityDigit: 9, MinQuantity: sdk.OneDec(), InitPrice: sdk.NewDec(2),
+	}
+	if _, err := handleMsgProposeTokenPairUpdate(ctx, keeper, propose, ctx.Logger()); err != nil {
+		t.Fatalf("unexpected error proposing the change: %v", err)
+	}
+	var changeID string
+	for _, c := range keeper.pendingChanges {
+		changeID = c.ChangeID
+	}
+
+	confirm := MsgConfirmTokenPairUpdate{Address: testAddr(3), Product: pair.Name(), ChangeID: changeID}
+	if _, err := handleMsgConfirmTokenPairUpdate(ctx, keeper, confirm, ctx.Logger()); err == nil {
+		t.Fatal("expected confirmation from an address other than ConfirmAddress to be rejected")
+	}
+}
+
+func TestHandleMsgConfirmTokenPairUpdate_RejectsAndClearsExpiredChange(t *testing.T) {
+	ctx := newTestContext()
+	keeper, pair := setupPendingChangeKeeper(t)
+	confirmAddr := testAddr(2)
+
+	pendingChange := &types.PendingChange{
+		Product:        pair.Name(),
+		ChangeID:       "deadbeef",
+		ProposedBy:     pair.Owner,
+		ConfirmAddress: confirmAddr,
+		Update: types.TokenPairUpdateFields{
+			MaxPriceDigit: 9, MaxQuantityDigit: 9, MinQuantity: sdk.OneDec(), InitPrice: sdk.NewDec(2),
+		},
+		Expire: ctx.BlockTime().Add(-time.Hour),
+	}
+	keeper.SetPendingChange(ctx, pendingChange)
+
+	confirm := MsgConfirmTokenPairUpdate{Address: confirmAddr, Product: pair.Name(), ChangeID: "deadbeef"}
+	if _, err := handleMsgConfirmTokenPairUpdate(ctx, keeper, confirm, ctx.Logger()); err == nil {
+		t.Fatal("expected an expired pending change to be rejected")
+	}
+	if keeper.HasPendingChange(ctx, pair.Name()) {
+		t.Error("an expired pending change must be deleted once a confirmation is attempted against it")
+	}
+	if keeper.GetTokenPair(ctx, pair.Name()).MaxPriceDigit == 9 {
+		t.Error("an expired change must not be applied to the token pair")
+	}
+}
+
+func TestHandleMsgConfirmTokenPairUpdate_RejectsUnknownChange(t *testing.T) {
+	ctx := newTestContext()
+	keeper, pair := setupPendingChangeKeeper(t)
+
+	confirm := MsgConfirmTokenPairUpdate{Address: testAddr(2), Product: pair.Name(), ChangeID: "nonexistent"}
+	if _, err := handleMsgConfirmTokenPairUpdate(ctx, keeper, confirm, ctx.Logger()); err == nil {
+		t.Fatal("expected confirming a nonexistent change to be rejected")
+	}
+}
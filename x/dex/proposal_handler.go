@@ -0,0 +1,64 @@
+package dex
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+// NewProposalHandler handles governance proposals that target the dex module:
+// DelistProposal and RelistProposal
+func NewProposalHandler(k IKeeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.DelistProposal:
+			return handleDelistProposal(ctx, k, c)
+		case types.RelistProposal:
+			return handleRelistProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized dex proposal content type: %T", c)
+		}
+	}
+}
+
+func handleDelistProposal(ctx sdk.Context, k IKeeper, p types.DelistProposal) error {
+	if k.GetTokenPair(ctx, p.Product) == nil {
+		return sdkerrors.Wrapf(types.ErrTokenPairNotFound, "product: %s", p.Product)
+	}
+
+	if err := k.DelistTokenPair(ctx, p.Product, p.Reason, p.EffectiveHeight); err != nil {
+		return sdkerrors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelistProposalPassed,
+			sdk.NewAttribute(types.AttributeKeyProduct, p.Product),
+			sdk.NewAttribute(types.AttributeKeyReason, p.Reason),
+			sdk.NewAttribute(types.AttributeKeyEffectiveHeight, sdk.NewInt(p.EffectiveHeight).String()),
+		),
+	)
+	return nil
+}
+
+func handleRelistProposal(ctx sdk.Context, k IKeeper, p types.RelistProposal) error {
+	if k.GetTokenPair(ctx, p.Product) == nil {
+		return sdkerrors.Wrapf(types.ErrTokenPairNotFound, "product: %s", p.Product)
+	}
+
+	if err := k.RelistTokenPair(ctx, p.Product, p.Reason, p.EffectiveHeight); err != nil {
+		return sdkerrors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRelistProposalPassed,
+			sdk.NewAttribute(types.AttributeKeyProduct, p.Product),
+			sdk.NewAttribute(types.AttributeKeyReason, p.Reason),
+			sdk.NewAttribute(types.AttributeKeyEffectiveHeight, sdk.NewInt(p.EffectiveHeight).String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,49 @@
+package dex
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+// NewQuerier creates a querier for the dex module
+func NewQuerier(keeper IKeeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryListCount:
+			return queryOperatorListCount(ctx, req, keeper)
+		case types.QueryPendingDelistings:
+			return queryPendingDelistings(ctx, keeper)
+		default:
+			return nil, sdkerrors.Wrap(types.ErrUnknownRequest, "unknown dex query endpoint")
+		}
+	}
+}
+
+func queryOperatorListCount(ctx sdk.Context, req abci.RequestQuery, keeper IKeeper) ([]byte, error) {
+	var params types.QueryOperatorListCountParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknownRequest, "failed to parse list-count query params")
+	}
+
+	window := keeper.GetCurrentListCount(ctx, params.Operator)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, window)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInternal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPendingDelistings(ctx sdk.Context, keeper IKeeper) ([]byte, error) {
+	pending := keeper.GetPendingDelistings(ctx)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, pending)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInternal, err.Error())
+	}
+	return bz, nil
+}
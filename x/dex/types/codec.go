@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc is the codec used by the dex module for amino (de)serialization
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers the dex module's concrete message types on the provided codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgList{}, "okexchain/dex/MsgList", nil)
+	cdc.RegisterConcrete(MsgListMini{}, "okexchain/dex/MsgListMini", nil)
+	cdc.RegisterConcrete(MsgDeposit{}, "okexchain/dex/MsgDeposit", nil)
+	cdc.RegisterConcrete(MsgWithdraw{}, "okexchain/dex/MsgWithdraw", nil)
+	cdc.RegisterConcrete(MsgTransferOwnership{}, "okexchain/dex/MsgTransferOwnership", nil)
+	cdc.RegisterConcrete(MsgConfirmOwnership{}, "okexchain/dex/MsgConfirmOwnership", nil)
+	cdc.RegisterConcrete(MsgCreateOperator{}, "okexchain/dex/MsgCreateOperator", nil)
+	cdc.RegisterConcrete(MsgUpdateOperator{}, "okexchain/dex/MsgUpdateOperator", nil)
+	cdc.RegisterConcrete(MsgPauseOperator{}, "okexchain/dex/MsgPauseOperator", nil)
+	cdc.RegisterConcrete(MsgBlockAddress{}, "okexchain/dex/MsgBlockAddress", nil)
+	cdc.RegisterConcrete(MsgUnblockAddress{}, "okexchain/dex/MsgUnblockAddress", nil)
+	cdc.RegisterConcrete(MsgClaimOperatorFees{}, "okexchain/dex/MsgClaimOperatorFees", nil)
+	cdc.RegisterConcrete(MsgProposeTokenPairUpdate{}, "okexchain/dex/MsgProposeTokenPairUpdate", nil)
+	cdc.RegisterConcrete(MsgConfirmTokenPairUpdate{}, "okexchain/dex/MsgConfirmTokenPairUpdate", nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConfirmOwnership represents a pending, two-phase transfer of a token pair's ownership
+type ConfirmOwnership struct {
+	Product     string         `json:"product"`
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	Expire      time.Time      `json:"expire"`
+}
+
+// String implements the stringer interface
+func (c ConfirmOwnership) String() string {
+	return fmt.Sprintf(`ConfirmOwnership:
+  Product:      %s
+  From Address: %s
+  To Address:   %s
+  Expire:       %s`,
+		c.Product, c.FromAddress, c.ToAddress, c.Expire)
+}
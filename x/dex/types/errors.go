@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/dex error codes, following the registered-error pattern introduced by
+// cosmos-sdk#5421. Each value is a *sdkerrors.Error that clients can compare
+// against directly (errors.Is) instead of string-matching a message.
+var (
+	ErrInternal                = sdkerrors.Register(ModuleName, 1, "internal error")
+	ErrUnauthorizedCaller      = sdkerrors.Register(ModuleName, 2, "unauthorized caller")
+	ErrUnknownOperator         = sdkerrors.Register(ModuleName, 3, "unknown dex operator")
+	ErrOperatorExists          = sdkerrors.Register(ModuleName, 4, "dex operator already exists")
+	ErrDuplicateTokenPair      = sdkerrors.Register(ModuleName, 5, "token pair already listed")
+	ErrTokenPairNotFound       = sdkerrors.Register(ModuleName, 6, "token pair not found")
+	ErrTokenPairSaveFailed     = sdkerrors.Register(ModuleName, 7, "failed to save token pair")
+	ErrInsufficientFee         = sdkerrors.Register(ModuleName, 8, "insufficient fee coins")
+	ErrInsufficientCoins       = sdkerrors.Register(ModuleName, 9, "insufficient coins")
+	ErrUnknownRequest          = sdkerrors.Register(ModuleName, 10, "unknown request")
+	ErrTokenPairKindCollision  = sdkerrors.Register(ModuleName, 11, "token pair already listed under a different kind")
+	ErrOperatorPaused          = sdkerrors.Register(ModuleName, 12, "dex operator is paused")
+	ErrAddressBlocked          = sdkerrors.Register(ModuleName, 13, "address is blocked by this operator")
+	ErrListRateLimitExceeded   = sdkerrors.Register(ModuleName, 14, "operator listing rate limit exceeded")
+	ErrOwnershipConfirmExpired = sdkerrors.Register(ModuleName, 15, "confirm window expired")
+	ErrNoAccruedOperatorFees   = sdkerrors.Register(ModuleName, 16, "operator has no accrued fees to claim")
+	ErrPendingChangeNotFound   = sdkerrors.Register(ModuleName, 17, "pending token pair change not found")
+	ErrPendingChangeConflict   = sdkerrors.Register(ModuleName, 18, "a token pair change is already pending for this product")
+)
@@ -0,0 +1,25 @@
+package types
+
+// dex module event types and attribute keys emitted outside of the generic
+// sdk.EventTypeMessage wrapper used by the message handlers
+const (
+	EventTypeDelistProposalPassed     = "delist_proposal_passed"
+	EventTypeRelistProposalPassed     = "relist_proposal_passed"
+	EventTypeOperatorFeePaid          = "operator_fee_paid"
+	EventTypeTokenPairUpdateProposed  = "token_pair_update_proposed"
+	EventTypeTokenPairUpdateConfirmed = "token_pair_update_confirmed"
+
+	AttributeKeyProduct         = "product"
+	AttributeKeyReason          = "reason"
+	AttributeKeyEffectiveHeight = "effective_height"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyChangeID        = "change_id"
+)
+
+// PendingDelisting records a delisting that has passed governance but whose
+// effective height has not yet been reached
+type PendingDelisting struct {
+	Product         string `json:"product"`
+	Reason          string `json:"reason"`
+	EffectiveHeight int64  `json:"effective_height"`
+}
@@ -0,0 +1,71 @@
+package types
+
+const (
+	// ModuleName is the name of the dex module
+	ModuleName = "dex"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the dex module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the dex module
+	QuerierRoute = ModuleName
+)
+
+// keys to access the store
+var (
+	TokenPairsStoreKeyPrefix     = []byte{0x01}
+	OperatorStoreKeyPrefix       = []byte{0x02}
+	ConfirmOwnershipKeyPrefix    = []byte{0x03}
+	UserTokenPairStoreKeyPrefix  = []byte{0x04}
+	ListCountStoreKeyPrefix      = []byte{0x05}
+	PendingDelistingKeyPrefix    = []byte{0x06}
+	AccruedOperatorFeesKeyPrefix = []byte{0x07}
+	PendingChangeKeyPrefix       = []byte{0x08}
+)
+
+// GetPendingChangeKey returns the store key for a pending token pair parameter
+// change, namespaced by both product and changeID
+func GetPendingChangeKey(product, changeID string) []byte {
+	key := append(PendingChangeKeyPrefix, []byte(product)...)
+	key = append(key, byte('/'))
+	return append(key, []byte(changeID)...)
+}
+
+// GetPendingChangeProductPrefix returns the store prefix covering every pending
+// change proposed for product, regardless of changeID
+func GetPendingChangeProductPrefix(product string) []byte {
+	return append(PendingChangeKeyPrefix, []byte(product+"/")...)
+}
+
+// GetAccruedOperatorFeesKey returns the store key for an operator's accrued, unclaimed fees
+func GetAccruedOperatorFeesKey(operator []byte) []byte {
+	return append(AccruedOperatorFeesKeyPrefix, operator...)
+}
+
+// GetPendingDelistingKey returns the store key for a pending (not-yet-effective) delisting
+func GetPendingDelistingKey(product string) []byte {
+	return append(PendingDelistingKeyPrefix, []byte(product)...)
+}
+
+// GetListCountKey returns the store key for an operator's current rate-limit window counter
+func GetListCountKey(operator []byte) []byte {
+	return append(ListCountStoreKeyPrefix, operator...)
+}
+
+// GetTokenPairAddressKey returns the store key for a token pair by product name
+func GetTokenPairAddressKey(product string) []byte {
+	return append(TokenPairsStoreKeyPrefix, []byte(product)...)
+}
+
+// GetOperatorAddressKey returns the store key for a dex operator
+func GetOperatorAddressKey(addr []byte) []byte {
+	return append(OperatorStoreKeyPrefix, addr...)
+}
+
+// GetConfirmOwnershipKey returns the store key for a pending ownership confirmation
+func GetConfirmOwnershipKey(product string) []byte {
+	return append(ConfirmOwnershipKeyPrefix, []byte(product)...)
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TypeMsgClaimOperatorFees is the message type for sweeping accrued operator fees
+const TypeMsgClaimOperatorFees = "claim_operator_fees"
+
+// MsgClaimOperatorFees lets an operator sweep its accrued share of listing/transfer/
+// register fees, held by the dex module account, to its HandlingFeeAddress.
+type MsgClaimOperatorFees struct {
+	Owner sdk.AccAddress `json:"owner"`
+}
+
+// NewMsgClaimOperatorFees creates a new MsgClaimOperatorFees
+func NewMsgClaimOperatorFees(owner sdk.AccAddress) MsgClaimOperatorFees {
+	return MsgClaimOperatorFees{Owner: owner}
+}
+
+// Route implements sdk.Msg
+func (msg MsgClaimOperatorFees) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgClaimOperatorFees) Type() string { return TypeMsgClaimOperatorFees }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgClaimOperatorFees) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgClaimOperatorFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgClaimOperatorFees) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TypeMsgListMini is the message type for a mini token pair listing
+const TypeMsgListMini = "list_mini"
+
+// MsgListMini is sent by a registered operator to list a new mini token pair.
+// Mini pairs trade a lower listing fee for tighter quantity/supply limits and a
+// restricted quote-asset whitelist, analogous to BEP8 mini-tokens.
+type MsgListMini struct {
+	Owner      sdk.AccAddress `json:"owner"`
+	ListAsset  string         `json:"list_asset"`
+	QuoteAsset string         `json:"quote_asset"`
+	InitPrice  sdk.Dec        `json:"init_price"`
+	MaxSupply  sdk.Dec        `json:"max_supply"`
+}
+
+// NewMsgListMini creates a new MsgListMini
+func NewMsgListMini(owner sdk.AccAddress, listAsset, quoteAsset string, initPrice, maxSupply sdk.Dec) MsgListMini {
+	return MsgListMini{Owner: owner, ListAsset: listAsset, QuoteAsset: quoteAsset, InitPrice: initPrice, MaxSupply: maxSupply}
+}
+
+// Route implements sdk.Msg
+func (msg MsgListMini) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgListMini) Type() string { return TypeMsgListMini }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgListMini) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if msg.ListAsset == "" || msg.QuoteAsset == "" {
+		return sdk.ErrUnknownRequest("list asset and quote asset must be set")
+	}
+	if !msg.InitPrice.IsPositive() {
+		return sdk.ErrUnknownRequest("init price must be positive")
+	}
+	if !msg.MaxSupply.IsPositive() {
+		return sdk.ErrUnknownRequest("max supply must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgListMini) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgListMini) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
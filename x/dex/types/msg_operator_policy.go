@@ -0,0 +1,105 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// message types for operator listing controls
+const (
+	TypeMsgPauseOperator  = "pause_operator"
+	TypeMsgBlockAddress   = "block_address"
+	TypeMsgUnblockAddress = "unblock_address"
+)
+
+// MsgPauseOperator pauses or unpauses all listings/deposits under an operator.
+// It may only be issued by the operator's own owner; there is no gov proposal route.
+type MsgPauseOperator struct {
+	Owner  sdk.AccAddress `json:"owner"`
+	Paused bool           `json:"paused"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgPauseOperator) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgPauseOperator) Type() string { return TypeMsgPauseOperator }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgPauseOperator) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgPauseOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPauseOperator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgBlockAddress blocks an address from depositing into token pairs owned by an operator.
+// It may only be issued by the operator's own owner; there is no gov proposal route.
+type MsgBlockAddress struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgBlockAddress) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgBlockAddress) Type() string { return TypeMsgBlockAddress }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgBlockAddress) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() || msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing owner/address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgBlockAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgBlockAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgUnblockAddress reverses a previous MsgBlockAddress.
+// It may only be issued by the operator's own owner; there is no gov proposal route.
+type MsgUnblockAddress struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgUnblockAddress) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUnblockAddress) Type() string { return TypeMsgUnblockAddress }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUnblockAddress) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() || msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing owner/address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUnblockAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUnblockAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
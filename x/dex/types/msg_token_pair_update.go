@@ -0,0 +1,125 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TypeMsgProposeTokenPairUpdate is the message type for proposing a token pair parameter update
+	TypeMsgProposeTokenPairUpdate = "propose_token_pair_update"
+	// TypeMsgConfirmTokenPairUpdate is the message type for confirming a pending token pair parameter update
+	TypeMsgConfirmTokenPairUpdate = "confirm_token_pair_update"
+)
+
+// MsgProposeTokenPairUpdate proposes a change to a listed token pair's trading
+// parameters. The change only takes effect once ConfirmAddress confirms it
+// with MsgConfirmTokenPairUpdate within TokenPairUpdateConfirmWindow; the
+// ownership confirm window (OwnershipConfirmWindow) does not apply to it.
+type MsgProposeTokenPairUpdate struct {
+	Owner            sdk.AccAddress `json:"owner"`
+	Product          string         `json:"product"`
+	ConfirmAddress   sdk.AccAddress `json:"confirm_address"`
+	MaxPriceDigit    int64          `json:"max_price_digit"`
+	MaxQuantityDigit int64          `json:"max_quantity_digit"`
+	MinQuantity      sdk.Dec        `json:"min_quantity"`
+	InitPrice        sdk.Dec        `json:"init_price"`
+}
+
+// NewMsgProposeTokenPairUpdate creates a new MsgProposeTokenPairUpdate
+func NewMsgProposeTokenPairUpdate(owner sdk.AccAddress, product string, confirmAddress sdk.AccAddress,
+	maxPriceDigit, maxQuantityDigit int64, minQuantity, initPrice sdk.Dec) MsgProposeTokenPairUpdate {
+	return MsgProposeTokenPairUpdate{
+		Owner:            owner,
+		Product:          product,
+		ConfirmAddress:   confirmAddress,
+		MaxPriceDigit:    maxPriceDigit,
+		MaxQuantityDigit: maxQuantityDigit,
+		MinQuantity:      minQuantity,
+		InitPrice:        initPrice,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgProposeTokenPairUpdate) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgProposeTokenPairUpdate) Type() string { return TypeMsgProposeTokenPairUpdate }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgProposeTokenPairUpdate) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if msg.ConfirmAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing confirm address")
+	}
+	if len(msg.Product) == 0 {
+		return sdk.ErrUnknownRequest("product cannot be empty")
+	}
+	if msg.MaxPriceDigit < 0 || msg.MaxPriceDigit > DefaultMaxPriceDigitSize {
+		return sdk.ErrUnknownRequest("max price digit must be between 0 and the default max price digit size")
+	}
+	if msg.MaxQuantityDigit < 0 || msg.MaxQuantityDigit > DefaultMaxQuantityDigitSize {
+		return sdk.ErrUnknownRequest("max quantity digit must be between 0 and the default max quantity digit size")
+	}
+	if msg.MinQuantity.IsNil() || msg.MinQuantity.IsNegative() {
+		return sdk.ErrUnknownRequest("min quantity cannot be negative")
+	}
+	if msg.InitPrice.IsNil() || !msg.InitPrice.IsPositive() {
+		return sdk.ErrUnknownRequest("init price must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgProposeTokenPairUpdate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgProposeTokenPairUpdate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgConfirmTokenPairUpdate confirms a pending token pair parameter change raised
+// by MsgProposeTokenPairUpdate, applying it to the token pair
+type MsgConfirmTokenPairUpdate struct {
+	Address  sdk.AccAddress `json:"address"`
+	Product  string         `json:"product"`
+	ChangeID string         `json:"change_id"`
+}
+
+// NewMsgConfirmTokenPairUpdate creates a new MsgConfirmTokenPairUpdate
+func NewMsgConfirmTokenPairUpdate(address sdk.AccAddress, product, changeID string) MsgConfirmTokenPairUpdate {
+	return MsgConfirmTokenPairUpdate{Address: address, Product: product, ChangeID: changeID}
+}
+
+// Route implements sdk.Msg
+func (msg MsgConfirmTokenPairUpdate) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgConfirmTokenPairUpdate) Type() string { return TypeMsgConfirmTokenPairUpdate }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConfirmTokenPairUpdate) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing confirming address")
+	}
+	if len(msg.Product) == 0 {
+		return sdk.ErrUnknownRequest("product cannot be empty")
+	}
+	if len(msg.ChangeID) == 0 {
+		return sdk.ErrUnknownRequest("change id cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgConfirmTokenPairUpdate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgConfirmTokenPairUpdate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func validProposeTokenPairUpdateMsg() MsgProposeTokenPairUpdate {
+	return MsgProposeTokenPairUpdate{
+		Owner:            sdk.AccAddress([]byte("owner_______________")),
+		Product:          "btc_okt",
+		ConfirmAddress:   sdk.AccAddress([]byte("confirm_____________")),
+		MaxPriceDigit:    DefaultMaxPriceDigitSize,
+		MaxQuantityDigit: DefaultMaxQuantityDigitSize,
+		MinQuantity:      sdk.OneDec(),
+		InitPrice:        sdk.OneDec(),
+	}
+}
+
+func TestMsgProposeTokenPairUpdate_ValidateBasic_RejectsDigitSizeAboveDefault(t *testing.T) {
+	msg := validProposeTokenPairUpdateMsg()
+	msg.MaxPriceDigit = DefaultMaxPriceDigitSize + 1
+	if err := msg.ValidateBasic(); err == nil {
+		t.Fatal("expected a max price digit above the default cap to be rejected")
+	}
+
+	msg = validProposeTokenPairUpdateMsg()
+	msg.MaxQuantityDigit = DefaultMaxQuantityDigitSize + 1
+	if err := msg.ValidateBasic(); err == nil {
+		t.Fatal("expected a max quantity digit above the default cap to be rejected")
+	}
+}
+
+func TestMsgProposeTokenPairUpdate_ValidateBasic_AllowsDigitSizeAtDefault(t *testing.T) {
+	msg := validProposeTokenPairUpdateMsg()
+	if err := msg.ValidateBasic(); err != nil {
+		t.Fatalf("expected the default digit sizes to validate, got %v", err)
+	}
+}
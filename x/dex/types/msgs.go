@@ -0,0 +1,284 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// dex message types
+const (
+	TypeMsgList              = "list"
+	TypeMsgDeposit           = "deposit"
+	TypeMsgWithdraw          = "withdraw"
+	TypeMsgTransferOwnership = "transfer_ownership"
+	TypeMsgConfirmOwnership  = "confirm_ownership"
+	TypeMsgCreateOperator    = "create_operator"
+	TypeMsgUpdateOperator    = "update_operator"
+)
+
+// MsgList is sent by a registered operator to list a new standard token pair
+type MsgList struct {
+	Owner      sdk.AccAddress `json:"owner"`
+	ListAsset  string         `json:"list_asset"`
+	QuoteAsset string         `json:"quote_asset"`
+	InitPrice  sdk.Dec        `json:"init_price"`
+}
+
+// NewMsgList creates a new MsgList
+func NewMsgList(owner sdk.AccAddress, listAsset, quoteAsset string, initPrice sdk.Dec) MsgList {
+	return MsgList{Owner: owner, ListAsset: listAsset, QuoteAsset: quoteAsset, InitPrice: initPrice}
+}
+
+// Route implements sdk.Msg
+func (msg MsgList) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgList) Type() string { return TypeMsgList }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgList) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if msg.ListAsset == "" || msg.QuoteAsset == "" {
+		return sdk.ErrUnknownRequest("list asset and quote asset must be set")
+	}
+	if !msg.InitPrice.IsPositive() {
+		return sdk.ErrUnknownRequest("init price must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgList) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgList) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgDeposit is sent to add deposits backing a listed token pair
+type MsgDeposit struct {
+	Product   string         `json:"product"`
+	Depositor sdk.AccAddress `json:"depositor"`
+	Amount    sdk.DecCoin    `json:"amount"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgDeposit) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgDeposit) Type() string { return TypeMsgDeposit }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgDeposit) ValidateBasic() sdk.Error {
+	if msg.Depositor.Empty() {
+		return sdk.ErrInvalidAddress("missing depositor address")
+	}
+	if msg.Product == "" {
+		return sdk.ErrUnknownRequest("missing product")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// MsgWithdraw is sent to withdraw deposits backing a listed token pair
+type MsgWithdraw struct {
+	Product   string         `json:"product"`
+	Depositor sdk.AccAddress `json:"depositor"`
+	Amount    sdk.DecCoin    `json:"amount"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgWithdraw) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgWithdraw) Type() string { return TypeMsgWithdraw }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgWithdraw) ValidateBasic() sdk.Error {
+	if msg.Depositor.Empty() {
+		return sdk.ErrInvalidAddress("missing depositor address")
+	}
+	if msg.Product == "" {
+		return sdk.ErrUnknownRequest("missing product")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// MsgTransferOwnership starts a two-phase transfer of a token pair's ownership
+type MsgTransferOwnership struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	Product     string         `json:"product"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgTransferOwnership) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgTransferOwnership) Type() string { return TypeMsgTransferOwnership }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTransferOwnership) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() || msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing from/to address")
+	}
+	if msg.Product == "" {
+		return sdk.ErrUnknownRequest("missing product")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgTransferOwnership) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgTransferOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgConfirmOwnership completes a pending MsgTransferOwnership
+type MsgConfirmOwnership struct {
+	Address sdk.AccAddress `json:"address"`
+	Product string         `json:"product"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgConfirmOwnership) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgConfirmOwnership) Type() string { return TypeMsgConfirmOwnership }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConfirmOwnership) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	if msg.Product == "" {
+		return sdk.ErrUnknownRequest("missing product")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgConfirmOwnership) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgConfirmOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// MsgCreateOperator registers the sender as a dex operator
+type MsgCreateOperator struct {
+	Owner              sdk.AccAddress `json:"owner"`
+	HandlingFeeAddress sdk.AccAddress `json:"handling_fee_address"`
+	Website            string         `json:"website"`
+	Blockable          bool           `json:"blockable"`
+	RateLimit          RateLimit      `json:"rate_limit"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateOperator) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateOperator) Type() string { return TypeMsgCreateOperator }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateOperator) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() || msg.HandlingFeeAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing owner/handling fee address")
+	}
+	if err := validateRateLimit(msg.RateLimit); err != nil {
+		return sdk.ErrUnknownRequest(err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateOperator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgUpdateOperator updates the mutable fields of a dex operator
+type MsgUpdateOperator struct {
+	Owner              sdk.AccAddress `json:"owner"`
+	HandlingFeeAddress sdk.AccAddress `json:"handling_fee_address"`
+	Website            string         `json:"website"`
+	Blockable          bool           `json:"blockable"`
+	RateLimit          RateLimit      `json:"rate_limit"`
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateOperator) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateOperator) Type() string { return TypeMsgUpdateOperator }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateOperator) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() || msg.HandlingFeeAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing owner/handling fee address")
+	}
+	if err := validateRateLimit(msg.RateLimit); err != nil {
+		return sdk.ErrUnknownRequest(err.Error())
+	}
+	return nil
+}
+
+// validateRateLimit rejects a RateLimit that claims to be Active but carries a
+// nil/non-positive Limit or a non-positive Period. A nil Limit would panic the
+// very first time handleMsgList evaluates window.Count.GTE(operator.RateLimit.Limit)
+func validateRateLimit(r RateLimit) error {
+	if !r.Active {
+		return nil
+	}
+	if r.Limit.IsNil() || !r.Limit.IsPositive() {
+		return fmt.Errorf("active rate limit must have a positive listing limit")
+	}
+	if r.Period <= 0 {
+		return fmt.Errorf("active rate limit must have a positive period")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateOperator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
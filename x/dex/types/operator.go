@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RateLimit governs how many token pairs an operator may list within a rolling period
+type RateLimit struct {
+	Active bool          `json:"active"`
+	Limit  sdk.Int       `json:"limit"`
+	Period time.Duration `json:"period"`
+}
+
+// String implements the stringer interface
+func (r RateLimit) String() string {
+	return fmt.Sprintf("active: %t, limit: %s, period: %s", r.Active, r.Limit, r.Period)
+}
+
+// DEXOperator represents an entity that is allowed to list token pairs on behalf of others
+type DEXOperator struct {
+	Address            sdk.AccAddress `json:"address"`
+	HandlingFeeAddress sdk.AccAddress `json:"handling_fee_address"`
+	Website            string         `json:"website"`
+	InitHeight         int64          `json:"init_height"`
+	TxHash             string         `json:"tx_hash"`
+
+	// Paused suspends all new listings and deposits under this operator
+	Paused bool `json:"paused"`
+	// Blockable controls whether the operator is allowed to block individual addresses
+	Blockable bool `json:"blockable"`
+	// BlockedAddresses may not deposit into token pairs owned by this operator
+	BlockedAddresses []sdk.AccAddress `json:"blocked_addresses"`
+	// RateLimit caps how many pairs this operator may list within a rolling window
+	RateLimit RateLimit `json:"rate_limit"`
+}
+
+// IsBlocked reports whether addr has been blocked by this operator
+func (d DEXOperator) IsBlocked(addr sdk.AccAddress) bool {
+	for _, blocked := range d.BlockedAddresses {
+		if blocked.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the stringer interface
+func (d DEXOperator) String() string {
+	return fmt.Sprintf(`DEXOperator:
+  Address:              %s
+  Handling Fee Address: %s
+  Website:              %s
+  Init Height:          %d
+  Tx Hash:              %s
+  Paused:               %t
+  Blockable:            %t
+  Blocked Addresses:    %v
+  Rate Limit:           %s`,
+		d.Address, d.HandlingFeeAddress, d.Website, d.InitHeight, d.TxHash,
+		d.Paused, d.Blockable, d.BlockedAddresses, d.RateLimit)
+}
+
+// ListCountWindow tracks how many pairs an operator has listed within the current rate-limit period
+type ListCountWindow struct {
+	Operator    sdk.AccAddress `json:"operator"`
+	Count       sdk.Int        `json:"count"`
+	PeriodStart time.Time      `json:"period_start"`
+}
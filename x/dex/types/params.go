@@ -0,0 +1,199 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// default parameter values
+const (
+	DefaultMaxPriceDigitSize    = 8
+	DefaultMaxQuantityDigitSize = 8
+
+	// DefaultMiniMinQuantity is the minimum trade size for a mini token pair, an order
+	// of magnitude tighter than the standard default
+	DefaultMiniMinQuantity = "0.000000001"
+	// DefaultMiniMaxSupply caps the total supply a mini token pair's base asset may mint to
+	DefaultMiniMaxSupply = "1000000000"
+)
+
+// default fee / deposit values, expressed in the native staking/fee denom
+var (
+	DefaultTokenPairDeposit = sdk.NewDec(0)
+	// DefaultMiniQuoteAssetWhitelist restricts mini pairs to quoting against the native coin
+	DefaultMiniQuoteAssetWhitelist = []string{"okt"}
+)
+
+// parameter keys
+var (
+	KeyListFee                      = []byte("ListFee")
+	KeyTransferOwnershipFee         = []byte("TransferOwnershipFee")
+	KeyOwnershipConfirmWindow       = []byte("OwnershipConfirmWindow")
+	KeyRegisterOperatorFee          = []byte("RegisterOperatorFee")
+	KeyListMiniFee                  = []byte("ListMiniFee")
+	KeyMiniMinQuantity              = []byte("MiniMinQuantity")
+	KeyMiniMaxSupply                = []byte("MiniMaxSupply")
+	KeyMiniQuoteAssetWhitelist      = []byte("MiniQuoteAssetWhitelist")
+	KeyOperatorFeeShare             = []byte("OperatorFeeShare")
+	KeyTokenPairUpdateConfirmWindow = []byte("TokenPairUpdateConfirmWindow")
+)
+
+// ParamKeyTable returns the param key table for the dex module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default dex module parameters used to seed genesis
+func DefaultParams() Params {
+	return Params{
+		ListFee:                      DecCoins(sdk.NewDecCoins(sdk.NewDecCoin("okt", sdk.NewInt(100)))),
+		TransferOwnershipFee:         DecCoins(sdk.NewDecCoins(sdk.NewDecCoin("okt", sdk.NewInt(100)))),
+		OwnershipConfirmWindow:       24 * time.Hour,
+		RegisterOperatorFee:          DecCoins(sdk.NewDecCoins(sdk.NewDecCoin("okt", sdk.NewInt(100)))),
+		ListMiniFee:                  DecCoins(sdk.NewDecCoins(sdk.NewDecCoin("okt", sdk.NewInt(10)))),
+		MiniMinQuantity:              sdk.MustNewDecFromStr(DefaultMiniMinQuantity),
+		MiniMaxSupply:                sdk.MustNewDecFromStr(DefaultMiniMaxSupply),
+		MiniQuoteAssetWhitelist:      DefaultMiniQuoteAssetWhitelist,
+		OperatorFeeShare:             sdk.NewDecWithPrec(2, 1),
+		TokenPairUpdateConfirmWindow: 24 * time.Hour,
+	}
+}
+
+// Params defines the parameters for the dex module
+type Params struct {
+	ListFee                DecCoins      `json:"list_fee"`
+	TransferOwnershipFee   DecCoins      `json:"transfer_ownership_fee"`
+	OwnershipConfirmWindow time.Duration `json:"ownership_confirm_window"`
+	RegisterOperatorFee    DecCoins      `json:"register_operator_fee"`
+
+	// ListMiniFee is the (lower) listing fee charged for a mini token pair
+	ListMiniFee DecCoins `json:"list_mini_fee"`
+	// MiniMinQuantity is the minimum trade size enforced for mini token pairs
+	MiniMinQuantity sdk.Dec `json:"mini_min_quantity"`
+	// MiniMaxSupply caps the base asset supply a mini token pair may be listed with
+	MiniMaxSupply sdk.Dec `json:"mini_max_supply"`
+	// MiniQuoteAssetWhitelist restricts which assets a mini pair may quote against
+	MiniQuoteAssetWhitelist []string `json:"mini_quote_asset_whitelist"`
+
+	// OperatorFeeShare is the fraction (0 to 1) of a listing/transfer/register fee that is
+	// routed to the originating operator's HandlingFeeAddress instead of the fee collector
+	OperatorFeeShare sdk.Dec `json:"operator_fee_share"`
+
+	// TokenPairUpdateConfirmWindow bounds how long a MsgProposeTokenPairUpdate stays
+	// open for confirmation, independently of OwnershipConfirmWindow
+	TokenPairUpdateConfirmWindow time.Duration `json:"token_pair_update_confirm_window"`
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyListFee, Value: &p.ListFee, ValidatorFn: validateDecCoins},
+		{Key: KeyTransferOwnershipFee, Value: &p.TransferOwnershipFee, ValidatorFn: validateDecCoins},
+		{Key: KeyOwnershipConfirmWindow, Value: &p.OwnershipConfirmWindow, ValidatorFn: validatePositiveDuration},
+		{Key: KeyRegisterOperatorFee, Value: &p.RegisterOperatorFee, ValidatorFn: validateDecCoins},
+		{Key: KeyListMiniFee, Value: &p.ListMiniFee, ValidatorFn: validateDecCoins},
+		{Key: KeyMiniMinQuantity, Value: &p.MiniMinQuantity, ValidatorFn: validateNonNegativeDec},
+		{Key: KeyMiniMaxSupply, Value: &p.MiniMaxSupply, ValidatorFn: validateNonNegativeDec},
+		{Key: KeyMiniQuoteAssetWhitelist, Value: &p.MiniQuoteAssetWhitelist, ValidatorFn: validateQuoteAssetWhitelist},
+		{Key: KeyOperatorFeeShare, Value: &p.OperatorFeeShare, ValidatorFn: validateOperatorFeeShare},
+		{Key: KeyTokenPairUpdateConfirmWindow, Value: &p.TokenPairUpdateConfirmWindow, ValidatorFn: validatePositiveDuration},
+	}
+}
+
+func validateDecCoins(i interface{}) error {
+	v, ok := i.(DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !sdk.DecCoins(v).IsValid() {
+		return fmt.Errorf("invalid fee coins: %s", v)
+	}
+	return nil
+}
+
+func validatePositiveDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("duration must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateNonNegativeDec(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("value must not be nil or negative: %s", v)
+	}
+	return nil
+}
+
+func validateQuoteAssetWhitelist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid quote asset denom %s: %w", denom, err)
+		}
+	}
+	return nil
+}
+
+// validateOperatorFeeShare enforces that OperatorFeeShare always stays a valid
+// fraction, so collectFeeWithOperatorSplit never has to split more of a fee
+// than was actually collected
+func validateOperatorFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("operator fee share must not be nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("operator fee share must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+// String implements the stringer interface
+func (p Params) String() string {
+	return fmt.Sprintf(`Dex Params:
+  List Fee:                    %s
+  Transfer Ownership Fee:      %s
+  Ownership Confirm Window:    %s
+  Register Operator Fee:       %s
+  List Mini Fee:               %s
+  Mini Min Quantity:           %s
+  Mini Max Supply:             %s
+  Mini Quote Asset Whitelist:  %v
+  Operator Fee Share:          %s
+  Token Pair Update Confirm Window: %s`,
+		p.ListFee, p.TransferOwnershipFee, p.OwnershipConfirmWindow, p.RegisterOperatorFee,
+		p.ListMiniFee, p.MiniMinQuantity, p.MiniMaxSupply, p.MiniQuoteAssetWhitelist, p.OperatorFeeShare,
+		p.TokenPairUpdateConfirmWindow)
+}
+
+// DecCoins is a thin alias kept local to the dex module so that fee params
+// can expose a ToCoins helper without importing the supply module here
+type DecCoins sdk.DecCoins
+
+// ToCoins truncates the dec coins down to sdk.Coins for fee deduction
+func (dc DecCoins) ToCoins() sdk.Coins {
+	return sdk.DecCoins(dc).TruncateDecimal()
+}
+
+// String implements the stringer interface
+func (dc DecCoins) String() string {
+	return sdk.DecCoins(dc).String()
+}
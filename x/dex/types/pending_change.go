@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenPairUpdateFields carries the subset of TokenPair trading parameters that
+// a PendingChange is allowed to apply once confirmed
+type TokenPairUpdateFields struct {
+	MaxPriceDigit    int64   `json:"max_price_digit"`
+	MaxQuantityDigit int64   `json:"max_quantity_digit"`
+	MinQuantity      sdk.Dec `json:"min_quantity"`
+	InitPrice        sdk.Dec `json:"init_price"`
+}
+
+// PendingChange is a two-phase, multi-sig-style update to a token pair that only
+// takes effect once ConfirmAddress confirms it within the window, generalizing
+// the ConfirmOwnership flow to parameter changes other than ownership transfer
+type PendingChange struct {
+	Product        string                `json:"product"`
+	ChangeID       string                `json:"change_id"`
+	ProposedBy     sdk.AccAddress        `json:"proposed_by"`
+	ConfirmAddress sdk.AccAddress        `json:"confirm_address"`
+	Update         TokenPairUpdateFields `json:"update"`
+	Expire         time.Time             `json:"expire"`
+}
+
+// String implements the stringer interface
+func (p PendingChange) String() string {
+	return fmt.Sprintf(`PendingChange:
+  Product:         %s
+  Change ID:       %s
+  Proposed By:     %s
+  Confirm Address: %s
+  Update:          %+v
+  Expire:          %s`,
+		p.Product, p.ChangeID, p.ProposedBy, p.ConfirmAddress, p.Update, p.Expire)
+}
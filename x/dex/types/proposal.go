@@ -0,0 +1,130 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeDelistTokenPair defines the type for a DelistProposal
+	ProposalTypeDelistTokenPair = "DelistTokenPair"
+	// ProposalTypeRelistTokenPair defines the type for a RelistProposal
+	ProposalTypeRelistTokenPair = "RelistTokenPair"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeDelistTokenPair)
+	govtypes.RegisterProposalTypeCodec(DelistProposal{}, "okexchain/dex/DelistProposal")
+	govtypes.RegisterProposalType(ProposalTypeRelistTokenPair)
+	govtypes.RegisterProposalTypeCodec(RelistProposal{}, "okexchain/dex/RelistProposal")
+}
+
+// DelistProposal lets the community mark a token pair as delisting, independent of
+// its owner, once the proposal passes gov voting.
+type DelistProposal struct {
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	Product         string `json:"product"`
+	Reason          string `json:"reason"`
+	EffectiveHeight int64  `json:"effective_height"`
+}
+
+// NewDelistProposal creates a new DelistProposal
+func NewDelistProposal(title, description, product, reason string, effectiveHeight int64) DelistProposal {
+	return DelistProposal{
+		Title:           title,
+		Description:     description,
+		Product:         product,
+		Reason:          reason,
+		EffectiveHeight: effectiveHeight,
+	}
+}
+
+// GetTitle implements gov.Content
+func (dp DelistProposal) GetTitle() string { return dp.Title }
+
+// GetDescription implements gov.Content
+func (dp DelistProposal) GetDescription() string { return dp.Description }
+
+// ProposalRoute implements gov.Content
+func (dp DelistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements gov.Content
+func (dp DelistProposal) ProposalType() string { return ProposalTypeDelistTokenPair }
+
+// ValidateBasic implements gov.Content
+func (dp DelistProposal) ValidateBasic() error {
+	if dp.Product == "" {
+		return ErrUnknownRequest
+	}
+	if dp.EffectiveHeight < 0 {
+		return ErrUnknownRequest
+	}
+	return govtypes.ValidateAbstract(dp)
+}
+
+// String implements the stringer interface
+func (dp DelistProposal) String() string {
+	return fmt.Sprintf(`Delist Token Pair Proposal:
+  Title:            %s
+  Description:      %s
+  Product:          %s
+  Reason:           %s
+  Effective Height: %d`,
+		dp.Title, dp.Description, dp.Product, dp.Reason, dp.EffectiveHeight)
+}
+
+// RelistProposal lets the community restore a previously delisted token pair
+type RelistProposal struct {
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	Product         string `json:"product"`
+	Reason          string `json:"reason"`
+	EffectiveHeight int64  `json:"effective_height"`
+}
+
+// NewRelistProposal creates a new RelistProposal
+func NewRelistProposal(title, description, product, reason string, effectiveHeight int64) RelistProposal {
+	return RelistProposal{
+		Title:           title,
+		Description:     description,
+		Product:         product,
+		Reason:          reason,
+		EffectiveHeight: effectiveHeight,
+	}
+}
+
+// GetTitle implements gov.Content
+func (rp RelistProposal) GetTitle() string { return rp.Title }
+
+// GetDescription implements gov.Content
+func (rp RelistProposal) GetDescription() string { return rp.Description }
+
+// ProposalRoute implements gov.Content
+func (rp RelistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements gov.Content
+func (rp RelistProposal) ProposalType() string { return ProposalTypeRelistTokenPair }
+
+// ValidateBasic implements gov.Content
+func (rp RelistProposal) ValidateBasic() error {
+	if rp.Product == "" {
+		return ErrUnknownRequest
+	}
+	if rp.EffectiveHeight < 0 {
+		return ErrUnknownRequest
+	}
+	return govtypes.ValidateAbstract(rp)
+}
+
+// String implements the stringer interface
+func (rp RelistProposal) String() string {
+	return fmt.Sprintf(`Relist Token Pair Proposal:
+  Title:            %s
+  Description:      %s
+  Product:          %s
+  Reason:           %s
+  Effective Height: %d`,
+		rp.Title, rp.Description, rp.Product, rp.Reason, rp.EffectiveHeight)
+}
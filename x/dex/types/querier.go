@@ -0,0 +1,19 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// query endpoints supported by the dex querier
+const (
+	QueryListCount         = "list-count"
+	QueryPendingDelistings = "pending-delistings"
+)
+
+// QueryOperatorListCountParams is the request payload for the list-count query
+type QueryOperatorListCountParams struct {
+	Operator sdk.AccAddress `json:"operator"`
+}
+
+// NewQueryOperatorListCountParams creates a new QueryOperatorListCountParams
+func NewQueryOperatorListCountParams(operator sdk.AccAddress) QueryOperatorListCountParams {
+	return QueryOperatorListCountParams{Operator: operator}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenPairKind distinguishes the listing class a TokenPair belongs to
+type TokenPairKind byte
+
+const (
+	// TokenPairKindStandard is a regular, fully-parameterized listing
+	TokenPairKindStandard TokenPairKind = 0x00
+	// TokenPairKindMini is a lightweight listing with tighter limits, analogous
+	// to BEP8 mini-tokens
+	TokenPairKindMini TokenPairKind = 0x01
+)
+
+// String implements the stringer interface
+func (k TokenPairKind) String() string {
+	switch k {
+	case TokenPairKindMini:
+		return "Mini"
+	default:
+		return "Standard"
+	}
+}
+
+// TokenPair represents an exchangeable pair of assets listed on the dex
+type TokenPair struct {
+	BaseAssetSymbol  string         `json:"base_asset_symbol"`
+	QuoteAssetSymbol string         `json:"quote_asset_symbol"`
+	InitPrice        sdk.Dec        `json:"init_price"`
+	MaxPriceDigit    int64          `json:"max_price_digit"`
+	MaxQuantityDigit int64          `json:"max_quantity_digit"`
+	MinQuantity      sdk.Dec        `json:"min_quantity"`
+	Owner            sdk.AccAddress `json:"owner"`
+	Delisting        bool           `json:"delisting"`
+	Deposits         sdk.Dec        `json:"deposits"`
+	BlockHeight      int64          `json:"block_height"`
+	Kind             TokenPairKind  `json:"kind"`
+	// MaxSupply caps the base asset supply enforceable for this pair. It is only
+	// ever set for TokenPairKindMini listings; a standard pair carries a nil Dec.
+	MaxSupply sdk.Dec `json:"max_supply"`
+}
+
+// Name returns the unique product name of the token pair, as used as the store key
+func (tp TokenPair) Name() string {
+	return fmt.Sprintf("%s_%s", tp.BaseAssetSymbol, tp.QuoteAssetSymbol)
+}
+
+// String implements the stringer interface
+func (tp TokenPair) String() string {
+	maxSupply := "unset"
+	if !tp.MaxSupply.IsNil() {
+		maxSupply = tp.MaxSupply.String()
+	}
+	return fmt.Sprintf(`TokenPair:
+  Base Asset Symbol:  %s
+  Quote Asset Symbol: %s
+  Init Price:         %s
+  Max Price Digit:    %d
+  Max Quantity Digit: %d
+  Min Quantity:       %s
+  Owner:              %s
+  Delisting:          %t
+  Deposits:           %s
+  Block Height:       %d
+  Kind:               %s
+  Max Supply:         %s`,
+		tp.BaseAssetSymbol, tp.QuoteAssetSymbol, tp.InitPrice, tp.MaxPriceDigit, tp.MaxQuantityDigit,
+		tp.MinQuantity, tp.Owner, tp.Delisting, tp.Deposits, tp.BlockHeight, tp.Kind, maxSupply)
+}